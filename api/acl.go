@@ -0,0 +1,55 @@
+package api
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/net"
+	"github.com/yottachain/YTCoreService/pkt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (accessor *ObjectAccessor) PutObjectAcl(buck, fileName string, Verid primitive.ObjectID, acl []byte) *pkt.ErrorMessage {
+	req := &pkt.PutObjectAclReqV2{
+		UserId:     &accessor.UClient.UserId,
+		SignData:   &accessor.UClient.SignKey.Sign,
+		KeyNumber:  &accessor.UClient.SignKey.KeyNumber,
+		BucketName: &buck,
+		FileName:   &fileName,
+		Acl:        acl,
+	}
+	if Verid != primitive.NilObjectID {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(Verid)
+		req.Vnu = &pkt.PutObjectAclReqV2_VNU{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+	}
+	_, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[PutObjectAcl][%d]%s/%s ERR:%s\n", accessor.UClient.UserId, buck, fileName, pkt.ToError(errmsg))
+		return errmsg
+	}
+	logrus.Infof("[PutObjectAcl][%d]%s/%s OK,acl:%s\n", accessor.UClient.UserId, buck, fileName, string(acl))
+	return nil
+}
+
+func (accessor *ObjectAccessor) GetObjectAcl(buck, fileName string, Verid primitive.ObjectID) ([]byte, *pkt.ErrorMessage) {
+	req := &pkt.GetObjectAclReqV2{
+		UserId:     &accessor.UClient.UserId,
+		SignData:   &accessor.UClient.SignKey.Sign,
+		KeyNumber:  &accessor.UClient.SignKey.KeyNumber,
+		BucketName: &buck,
+		FileName:   &fileName,
+	}
+	if Verid != primitive.NilObjectID {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(Verid)
+		req.Vnu = &pkt.GetObjectAclReqV2_VNU{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+	}
+	resp, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[GetObjectAcl][%d]%s/%s ERR:%s\n", accessor.UClient.UserId, buck, fileName, pkt.ToError(errmsg))
+		return nil, errmsg
+	}
+	dresp, OK := resp.(*pkt.GetObjectAclResp)
+	if !OK {
+		logrus.Errorf("[GetObjectAcl][%d]%s/%s RETURN_ERR_MSG\n", accessor.UClient.UserId, buck, fileName)
+		return nil, pkt.NewErrorMsg(pkt.SERVER_ERROR, "Return err msg type")
+	}
+	return dresp.Acl, nil
+}
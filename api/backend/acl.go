@@ -0,0 +1,89 @@
+package backend
+
+import (
+	"github.com/yottachain/YTCoreService/api"
+	"github.com/yottachain/YTCoreService/s3"
+)
+
+const (
+	bucketMetaACL    = "acl"
+	bucketMetaPolicy = "policy"
+)
+
+func (db *YTFS) BucketACL(publicKey, bucketName string) (s3.CannedACL, error) {
+	_, meta, err := db.bucketMeta(publicKey, bucketName)
+	if err != nil {
+		return "", err
+	}
+	return s3.ParseCannedACL(meta[bucketMetaACL])
+}
+
+func (db *YTFS) SetBucketACL(publicKey, bucketName string, acl s3.CannedACL) error {
+	if acl == s3.ACLPrivate {
+		return db.deleteBucketMeta(publicKey, bucketName, bucketMetaACL)
+	}
+	return db.setBucketMeta(publicKey, bucketName, bucketMetaACL, string(acl))
+}
+
+func (db *YTFS) ObjectACL(publicKey, bucketName, objectName string, versionID s3.VersionID) (s3.CannedACL, error) {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return "", er
+	}
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return "", err
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return "", s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	acl, errmsg := c.NewObjectAccessor().GetObjectAcl(bucketName, objectName, verid)
+	if errmsg != nil {
+		return "", taggingError(errmsg, bucketName, objectName)
+	}
+	return s3.ParseCannedACL(string(acl))
+}
+
+func (db *YTFS) SetObjectACL(publicKey, bucketName, objectName string, versionID s3.VersionID, acl s3.CannedACL) error {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return er
+	}
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return err
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	bs := []byte{}
+	if acl != s3.ACLPrivate {
+		bs = []byte(acl)
+	}
+	if errmsg := c.NewObjectAccessor().PutObjectAcl(bucketName, objectName, verid, bs); errmsg != nil {
+		return taggingError(errmsg, bucketName, objectName)
+	}
+	return nil
+}
+
+func (db *YTFS) BucketPolicy(publicKey, bucketName string) ([]byte, error) {
+	_, meta, err := db.bucketMeta(publicKey, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := meta[bucketMetaPolicy]
+	if !ok || s == "" {
+		return nil, s3.ResourceError(s3.ErrNoSuchBucketPolicy, bucketName)
+	}
+	return []byte(s), nil
+}
+
+func (db *YTFS) SetBucketPolicy(publicKey, bucketName string, policy []byte) error {
+	return db.setBucketMeta(publicKey, bucketName, bucketMetaPolicy, string(policy))
+}
+
+func (db *YTFS) DeleteBucketPolicy(publicKey, bucketName string) error {
+	return db.deleteBucketMeta(publicKey, bucketName, bucketMetaPolicy)
+}
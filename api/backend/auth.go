@@ -0,0 +1,21 @@
+package backend
+
+import (
+	"github.com/yottachain/YTCoreService/api"
+	"github.com/yottachain/YTCoreService/s3"
+)
+
+// SecretKey returns the secret derived from the private key of publicKey;
+// the private key itself is never used to sign S3 requests.
+func (db *YTFS) SecretKey(publicKey string) (string, error) {
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return "", s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	for _, k := range c.KeyMap {
+		if k.PublicKey == publicKey && k.PrivateKey != "" {
+			return s3.DeriveSecretKey(k.PrivateKey), nil
+		}
+	}
+	return "", s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+}
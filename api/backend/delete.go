@@ -13,6 +13,9 @@ func (db *YTFS) deleteObject(publicKey, bucketName, objectName string, c *api.Cl
 	err := objectAccessor.DeleteObject(bucketName, objectName, primitive.ObjectID{})
 	if err != nil {
 		logrus.Errorf("[S3Delete]/%s/%s,Err:%s\n", bucketName, objectName, err)
+		if err.Code == pkt.OBJECT_LOCKED {
+			return result, s3.ErrObjectLocked
+		}
 		return
 	}
 	return result, nil
@@ -51,7 +51,7 @@ func (bi *bucketData) toObject(rangeRequest *s3.ObjectRangeRequest, withBody boo
 	}, nil
 }
 
-func (db *YTFS) getObjectV2(publicKey, bucketName, objectName string, rangeRequest *s3.ObjectRangeRequest, prefix *s3.Prefix, page s3.ListBucketPage) (*s3.Object, error) {
+func (db *YTFS) getObjectV2(publicKey, bucketName, objectName string, version primitive.ObjectID, key *s3.CustomerKey, rangeRequest *s3.ObjectRangeRequest, prefix *s3.Prefix, page s3.ListBucketPage) (*s3.Object, error) {
 	count := atomic.AddInt32(GetObjectNum, 1)
 	defer atomic.AddInt32(GetObjectNum, -1)
 	if count > int32(MaxGetObjNum) {
@@ -67,15 +67,28 @@ func (db *YTFS) getObjectV2(publicKey, bucketName, objectName string, rangeReque
 	}
 	var metabs []byte
 	var t time.Time
-	download, errMsg := c.NewDownloadLastVersion(bucketName, objectName)
+	var download *api.DownloadObject
+	var errMsg *pkt.ErrorMessage
+	if key != nil {
+		download, errMsg = c.NewDownloadFileWithKey(bucketName, objectName, version, key.Key)
+	} else {
+		download, errMsg = c.NewDownloadFile(bucketName, objectName, version)
+	}
 	if errMsg != nil {
-		logrus.Errorf("[S3Download]NewDownloadLastVersion err:%s\n", errMsg)
-		if errMsg.Code == pkt.INVALID_OBJECT_NAME {
+		logrus.Errorf("[S3Download]NewDownloadFile err:%s\n", errMsg)
+		if errMsg.Code == pkt.CUSTOMER_KEY_REQUIRED && (key != nil || version != primitive.NilObjectID) {
+			return nil, s3.CustomerKeyError(key)
+		} else if errMsg.Code == pkt.INVALID_OBJECT_NAME && version != primitive.NilObjectID {
+			return nil, s3.ResourceError(s3.ErrNoSuchVersion, version.Hex())
+		} else if errMsg.Code == pkt.INVALID_OBJECT_NAME || errMsg.Code == pkt.CUSTOMER_KEY_REQUIRED {
+			// Objects under a customer key read without it only show their
+			// metadata, e.g. to check write preconditions; the body stays
+			// locked.
 			items, err := c.NewObjectAccessor().ListObject(bucketName, "", objectName, false, primitive.NilObjectID, uint32(page.MaxKeys))
 			if err != nil {
 				return nil, pkt.ToError(errMsg)
 			}
-			if len(items) > 0 {
+			if len(items) > 0 && items[0].FileName == objectName {
 				metabs = items[0].Meta
 				t = items[0].FileId.Timestamp()
 			} else {
@@ -87,11 +100,19 @@ func (db *YTFS) getObjectV2(publicKey, bucketName, objectName string, rangeReque
 	} else {
 		metabs = download.Meta
 		t = download.GetTime()
+		if version != primitive.NilObjectID {
+			t = version.Timestamp()
+		}
 	}
 	meta, err := api.BytesToFileMetaMap(metabs, primitive.NilObjectID)
 	if err != nil {
 		return nil, err
 	}
+	if key != nil {
+		if err := s3.CheckCustomerKey(meta, key); err != nil {
+			return nil, err
+		}
+	}
 	meta["x-amz-meta-s3b-last-modified"] = t.Format("20060102T150405Z")
 	content := GetContentByMeta(meta)
 	content.Key = objectName
@@ -113,17 +134,16 @@ func (db *YTFS) getObjectV2(publicKey, bucketName, objectName string, rangeReque
 	}
 	content = GetContentByMeta(result.Metadata)
 	result.Size = content.Size
-	if result.Size > 0 {
-		if rangeRequest != nil {
-			if rangeRequest.End == -1 {
-				rangeRequest.End = content.Size
-				rangeRequest.FromEnd = true
-			}
-			result.Contents = download.LoadRange(rangeRequest.Start, rangeRequest.End)
-			result.Range = &s3.ObjectRange{
-				Start:  rangeRequest.Start,
-				Length: rangeRequest.End - rangeRequest.Start,
+	if result.Size > 0 && meta[s3.SSECustomerKeyMD5] != "" && (key == nil || download == nil) {
+		result.Contents = lockedReader{}
+	} else if result.Size > 0 {
+		if key != nil {
+			if errMsg = download.SetCustomerKey(key.Key); errMsg != nil {
+				return nil, pkt.ToError(errMsg)
 			}
+		}
+		if result.Range != nil {
+			result.Contents = download.LoadRange(result.Range.Start, result.Range.Start+result.Range.Length)
 		} else {
 			result.Contents = download.Load()
 		}
@@ -132,9 +152,24 @@ func (db *YTFS) getObjectV2(publicKey, bucketName, objectName string, rangeReque
 	}
 	hash, _ = hex.DecodeString(content.ETag)
 	result.Hash = hash
+	result.LastModified = t
+	if version != primitive.NilObjectID {
+		result.VersionID = s3.VersionID(version.Hex())
+	}
 	return result, nil
 }
 
+// lockedReader stands in for the body of an SSE-C object read without its key.
+type lockedReader struct{}
+
+func (lockedReader) Read(p []byte) (int, error) {
+	return 0, s3.ErrAccessDenied
+}
+
+func (lockedReader) Close() error {
+	return nil
+}
+
 type ZeroReader struct {
 	io.ReadCloser
 }
@@ -148,9 +183,9 @@ func (cr *ZeroReader) Read(buf []byte) (int, error) {
 }
 
 func (db *YTFS) HeadObject(publicKey, bucketName, objectName string) (*s3.Object, error) {
-	return db.getObjectV2(publicKey, bucketName, objectName, nil, nil, s3.ListBucketPage{})
+	return db.getObjectV2(publicKey, bucketName, objectName, primitive.NilObjectID, nil, nil, nil, s3.ListBucketPage{})
 }
 
 func (db *YTFS) GetObject(publicKey, bucketName, objectName string, rangeRequest *s3.ObjectRangeRequest) (*s3.Object, error) {
-	return db.getObjectV2(publicKey, bucketName, objectName, rangeRequest, nil, s3.ListBucketPage{})
+	return db.getObjectV2(publicKey, bucketName, objectName, primitive.NilObjectID, nil, rangeRequest, nil, s3.ListBucketPage{})
 }
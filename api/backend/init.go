@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/yottachain/YTCoreService/api"
@@ -16,6 +17,7 @@ var defauleFs *YTFS
 var (
 	Object_Timeout int = 60
 	SyncFileMin    int
+	StreamUpload   bool
 	MaxGetObjNum   int
 	MaxListNum     int
 )
@@ -40,15 +42,18 @@ func InitObjectUpPool() {
 	MaxCreateObjNum := env.GetConfig().GetRangeInt("MaxCreateObjNum", 20, 500, 50)
 	Object_Timeout = env.GetConfig().GetRangeInt("ObjectTimeout", 10, 300, 60)
 	SyncFileMin = env.GetConfig().GetRangeInt("SyncFileMin", 1, 10, 2) * 1024 * 1024
+	StreamUpload = env.GetConfig().GetBool("StreamUpload", true)
 	Object_UP_CH = make(chan int, MaxCreateObjNum)
 	for ii := 0; ii < MaxCreateObjNum; ii++ {
 		Object_UP_CH <- 1
 	}
 	MaxGetObjNum = env.GetConfig().GetRangeInt("MaxGetObjNum", 20, 100, 50)
 	MaxListNum = env.GetConfig().GetRangeInt("MaxListNum", 1, 10, 2)
+	s3.MultipartUploadExpiry = time.Duration(env.GetConfig().GetRangeInt("MultipartExpireHours", 1, 24*30, 72)) * time.Hour
 }
 
 var httpserver *http.Server
+var websiteserver *http.Server
 
 func StartS3() {
 	fs := NewYTFS()
@@ -57,30 +62,45 @@ func StartS3() {
 	if err != nil {
 		logrus.Panicf("[S3]Listen %s ERR:%s", addr, err)
 	}
-	httpserver = &http.Server{Addr: addr, Handler: s3.NewS3(fs).Server()}
-	go func() {
-		if env.CertFilePath != "" {
-			err := httpserver.ServeTLS(listener, env.CertFilePath, env.KeyFilePath)
-			if err == nil {
-				logrus.Infof("[S3]Start S3 server https port :%d\n", listener.Addr().(*net.TCPAddr).Port)
-			} else {
-				listener.Close()
-				logrus.Infof("[S3]Start S3 server ERR:%s\n", err)
-			}
+	server := s3.NewS3(fs)
+	httpserver = &http.Server{Addr: addr, Handler: server.Server()}
+	go serve(httpserver, listener, "S3 server")
+	if env.S3WebsitePort > 0 {
+		waddr := fmt.Sprintf(":%d", env.S3WebsitePort)
+		wlistener, err := net.Listen("tcp", waddr)
+		if err != nil {
+			logrus.Panicf("[S3]Listen %s ERR:%s", waddr, err)
+		}
+		websiteserver = &http.Server{Addr: waddr, Handler: server.WebsiteServer()}
+		go serve(websiteserver, wlistener, "S3 website")
+	}
+}
+
+func serve(server *http.Server, listener net.Listener, name string) {
+	if env.CertFilePath != "" {
+		err := server.ServeTLS(listener, env.CertFilePath, env.KeyFilePath)
+		if err == nil {
+			logrus.Infof("[S3]Start %s https port :%d\n", name, listener.Addr().(*net.TCPAddr).Port)
 		} else {
-			err := httpserver.Serve(listener)
-			if err == nil {
-				logrus.Infof("[S3]Start S3 server http port :%d\n", listener.Addr().(*net.TCPAddr).Port)
-			} else {
-				listener.Close()
-				logrus.Infof("[S3]Start S3 server ERR:%s\n", err)
-			}
+			listener.Close()
+			logrus.Infof("[S3]Start %s ERR:%s\n", name, err)
 		}
-	}()
+	} else {
+		err := server.Serve(listener)
+		if err == nil {
+			logrus.Infof("[S3]Start %s http port :%d\n", name, listener.Addr().(*net.TCPAddr).Port)
+		} else {
+			listener.Close()
+			logrus.Infof("[S3]Start %s ERR:%s\n", name, err)
+		}
+	}
 }
 
 func StopS3() {
 	if httpserver != nil {
 		httpserver.Close()
 	}
+	if websiteserver != nil {
+		websiteserver.Close()
+	}
 }
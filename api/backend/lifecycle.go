@@ -0,0 +1,94 @@
+package backend
+
+import (
+	"github.com/yottachain/YTCoreService/api"
+	"github.com/yottachain/YTCoreService/pkt"
+	"github.com/yottachain/YTCoreService/s3"
+)
+
+func (db *YTFS) bucketMeta(publicKey, bucketName string) (*api.BucketAccessor, map[string]string, error) {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return nil, nil, er
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return nil, nil, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	bucketAccessor := c.NewBucketAccessor()
+	bs, errmsg := bucketAccessor.GetBucket(bucketName)
+	if errmsg != nil {
+		if errmsg.Code == pkt.INVALID_BUCKET_NAME {
+			return nil, nil, s3.BucketNotFound(bucketName)
+		}
+		return nil, nil, pkt.ToError(errmsg)
+	}
+	meta, err := api.BytesToBucketMetaMap(bs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return bucketAccessor, meta, nil
+}
+
+// setBucketMeta sets a single key of the bucket meta on the SN, which
+// applies it atomically to the meta stored at that time.
+func (db *YTFS) setBucketMeta(publicKey, bucketName, key, value string) error {
+	return db.changeBucketMeta(publicKey, bucketName, func(buck *api.BucketAccessor) *pkt.ErrorMessage {
+		return buck.SetBucketMeta(bucketName, key, value)
+	})
+}
+
+func (db *YTFS) deleteBucketMeta(publicKey, bucketName, key string) error {
+	return db.changeBucketMeta(publicKey, bucketName, func(buck *api.BucketAccessor) *pkt.ErrorMessage {
+		return buck.DeleteBucketMeta(bucketName, key)
+	})
+}
+
+func (db *YTFS) changeBucketMeta(publicKey, bucketName string, change func(*api.BucketAccessor) *pkt.ErrorMessage) error {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return er
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	if errmsg := change(c.NewBucketAccessor()); errmsg != nil {
+		switch errmsg.Code {
+		case pkt.INVALID_BUCKET_NAME:
+			return s3.BucketNotFound(bucketName)
+		case pkt.INVALID_ARGS:
+			return s3.ErrorMessage(s3.ErrInvalidRequest, errmsg.GetMsg())
+		}
+		return pkt.ToError(errmsg)
+	}
+	return nil
+}
+
+func (db *YTFS) LifecycleConfiguration(publicKey, bucketName string) (*s3.LifecycleConfiguration, error) {
+	_, meta, err := db.bucketMeta(publicKey, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := meta[pkt.BucketMetaLifecycle]
+	if !ok || s == "" {
+		return nil, s3.ResourceError(s3.ErrNoSuchLifecycleConfiguration, bucketName)
+	}
+	conf, err := pkt.ParseLifecycle([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	return s3.LifecycleFromPkt(conf), nil
+}
+
+func (db *YTFS) SetLifecycleConfiguration(publicKey, bucketName string, config *s3.LifecycleConfiguration) error {
+	bs, err := config.ToPkt().Marshal()
+	if err != nil {
+		return err
+	}
+	return db.setBucketMeta(publicKey, bucketName, pkt.BucketMetaLifecycle, string(bs))
+}
+
+func (db *YTFS) DeleteLifecycleConfiguration(publicKey, bucketName string) error {
+	return db.deleteBucketMeta(publicKey, bucketName, pkt.BucketMetaLifecycle)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -91,24 +92,41 @@ func (me *YTFS) ListBucket(publicKey, name string, prefix *s3.Prefix, page s3.Li
 	if c == nil {
 		return nil, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
 	}
-	objectAccessor := c.NewObjectAccessor()
-	startFile := ""
+	if page.MaxKeys <= 0 {
+		return response, nil
+	}
+	marker := ""
 	if page.HasMarker {
-		startFile = page.Marker
+		marker = page.Marker
 	}
-	pfix := ""
+	pfix, delimiter := "", ""
 	if prefix.HasPrefix {
 		pfix = prefix.Prefix
 	}
-	items, err := objectAccessor.ListObject(name, startFile, pfix, false, primitive.NilObjectID, uint32(page.MaxKeys))
-	if err != nil {
-		return response, fmt.Errorf(err.String())
+	if prefix.HasDelimiter {
+		delimiter = prefix.Delimiter
+	}
+	items, prefixes, truncated, errmsg := c.NewObjectAccessor().ListObjectByName(name, marker, pfix, delimiter, uint32(page.MaxKeys))
+	if errmsg != nil {
+		return response, fmt.Errorf(errmsg.String())
 	}
-	logrus.Infof("[ListObjects]Response %d items\n", len(items))
+	logrus.Infof("[ListObjects]Response %d items,%d prefixes\n", len(items), len(prefixes))
 	lastFile := ""
-	num := 0
+	for _, cp := range prefixes {
+		response.AddPrefix(cp)
+		lastFile = cp
+	}
 	for _, v := range items {
-		num++
+		if lastFile < v.FileName {
+			lastFile = v.FileName
+		}
+		if delimiter != "" {
+			rest := strings.TrimPrefix(v.FileName, pfix)
+			if i := strings.Index(rest, delimiter); i >= 0 {
+				response.AddPrefix(pfix + rest[:i+len(delimiter)])
+				continue
+			}
+		}
 		meta, err := api.BytesToFileMetaMap(v.Meta, primitive.ObjectID{})
 		if err != nil {
 			logrus.Warnf("[ListObjects]ERR meta,filename:%s\n", v.FileName)
@@ -123,9 +141,8 @@ func (me *YTFS) ListBucket(publicKey, name string, prefix *s3.Prefix, page s3.Li
 			DisplayName: c.Username,
 		}
 		response.Contents = append(response.Contents, content)
-		lastFile = v.FileName
 	}
-	if int64(num) >= page.MaxKeys {
+	if truncated {
 		response.NextMarker = lastFile
 		response.IsTruncated = true
 	}
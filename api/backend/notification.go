@@ -0,0 +1,63 @@
+package backend
+
+import (
+	"github.com/yottachain/YTCoreService/pkt"
+	"github.com/yottachain/YTCoreService/s3"
+)
+
+func (db *YTFS) BucketNotification(publicKey, bucketName string) (*s3.NotificationConfiguration, error) {
+	_, meta, err := db.bucketMeta(publicKey, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := meta[pkt.BucketMetaNotification]
+	if !ok || s == "" {
+		return &s3.NotificationConfiguration{}, nil
+	}
+	conf, err := pkt.ParseNotification([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	return s3Notification(conf), nil
+}
+
+func (db *YTFS) SetBucketNotification(publicKey, bucketName string, conf *s3.NotificationConfiguration) error {
+	if len(conf.Webhooks) == 0 {
+		return db.deleteBucketMeta(publicKey, bucketName, pkt.BucketMetaNotification)
+	}
+	bs, err := pktNotification(conf).Marshal()
+	if err != nil {
+		return err
+	}
+	return db.setBucketMeta(publicKey, bucketName, pkt.BucketMetaNotification, string(bs))
+}
+
+func pktNotification(config *s3.NotificationConfiguration) *pkt.NotificationConfiguration {
+	conf := &pkt.NotificationConfiguration{}
+	for _, w := range config.Webhooks {
+		hook := &pkt.WebhookConfiguration{ID: w.ID, Endpoint: w.Endpoint, Events: w.Events}
+		if w.Filter != nil {
+			hook.Filter = &pkt.NotificationFilter{}
+			for _, r := range w.Filter.Rules {
+				hook.Filter.Rules = append(hook.Filter.Rules, &pkt.FilterRule{Name: r.Name, Value: r.Value})
+			}
+		}
+		conf.Webhooks = append(conf.Webhooks, hook)
+	}
+	return conf
+}
+
+func s3Notification(conf *pkt.NotificationConfiguration) *s3.NotificationConfiguration {
+	config := &s3.NotificationConfiguration{}
+	for _, w := range conf.Webhooks {
+		hook := &s3.WebhookConfiguration{ID: w.ID, Endpoint: w.Endpoint, Events: w.Events}
+		if w.Filter != nil {
+			hook.Filter = &s3.NotificationFilter{}
+			for _, r := range w.Filter.Rules {
+				hook.Filter.Rules = append(hook.Filter.Rules, &s3.FilterRule{Name: r.Name, Value: r.Value})
+			}
+		}
+		config.Webhooks = append(config.Webhooks, hook)
+	}
+	return config
+}
@@ -0,0 +1,156 @@
+package backend
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/api"
+	"github.com/yottachain/YTCoreService/pkt"
+	"github.com/yottachain/YTCoreService/s3"
+)
+
+func (db *YTFS) ObjectLockConfiguration(publicKey, bucketName string) (*s3.ObjectLockConfiguration, error) {
+	_, meta, err := db.bucketMeta(publicKey, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := meta[pkt.BucketMetaObjectLock]
+	if !ok || s == "" {
+		return nil, s3.ResourceError(s3.ErrNoSuchObjectLockConfiguration, bucketName)
+	}
+	conf, err := pkt.ParseObjectLockConfiguration([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	config := &s3.ObjectLockConfiguration{ObjectLockEnabled: conf.ObjectLockEnabled}
+	if conf.Rule != nil && conf.Rule.DefaultRetention != nil {
+		r := conf.Rule.DefaultRetention
+		config.Rule = &s3.ObjectLockRule{DefaultRetention: &s3.DefaultRetention{Mode: r.Mode, Days: r.Days, Years: r.Years}}
+	}
+	return config, nil
+}
+
+// SetObjectLockConfiguration enables object lock, and with it versioning,
+// or replaces the default retention of a bucket.
+func (db *YTFS) SetObjectLockConfiguration(publicKey, bucketName string, conf *s3.ObjectLockConfiguration) error {
+	lock := &pkt.ObjectLockConfiguration{ObjectLockEnabled: conf.ObjectLockEnabled}
+	if conf.Rule != nil && conf.Rule.DefaultRetention != nil {
+		r := conf.Rule.DefaultRetention
+		lock.Rule = &pkt.ObjectLockRule{DefaultRetention: &pkt.DefaultRetention{Mode: r.Mode, Days: r.Days, Years: r.Years}}
+	}
+	bs, err := lock.Marshal()
+	if err != nil {
+		return err
+	}
+	return db.setBucketMeta(publicKey, bucketName, pkt.BucketMetaObjectLock, string(bs))
+}
+
+func objectLockError(errmsg *pkt.ErrorMessage, bucketName, objectName string) error {
+	switch errmsg.Code {
+	case pkt.OBJECT_LOCKED:
+		return s3.ErrObjectLocked
+	case pkt.INVALID_ARGS:
+		return s3.ErrorMessage(s3.ErrInvalidRequest, errmsg.GetMsg())
+	default:
+		return taggingError(errmsg, bucketName, objectName)
+	}
+}
+
+func (db *YTFS) objectLock(publicKey, bucketName, objectName string, versionID s3.VersionID) (*pkt.ObjectLock, error) {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return nil, er
+	}
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return nil, err
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return nil, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	lock, errmsg := c.NewObjectAccessor().GetObjectLock(bucketName, objectName, verid)
+	if errmsg != nil {
+		return nil, objectLockError(errmsg, bucketName, objectName)
+	}
+	return lock, nil
+}
+
+func (db *YTFS) ObjectRetention(publicKey, bucketName, objectName string, versionID s3.VersionID) (*s3.ObjectRetention, error) {
+	lock, err := db.objectLock(publicKey, bucketName, objectName, versionID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Mode == "" {
+		return nil, s3.ErrorMessage(s3.ErrNoSuchObjectLockConfiguration, "The specified object does not have a ObjectLock configuration")
+	}
+	return &s3.ObjectRetention{Mode: lock.Mode, RetainUntilDate: lock.RetainUntilDate()}, nil
+}
+
+func (db *YTFS) PutObjectRetention(publicKey, bucketName, objectName string, versionID s3.VersionID, retention *s3.ObjectRetention, bypass bool) error {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return er
+	}
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return err
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	if errmsg := c.NewObjectAccessor().PutObjectRetention(bucketName, objectName, verid, retention.Mode, retention.Until(), bypass); errmsg != nil {
+		return objectLockError(errmsg, bucketName, objectName)
+	}
+	return nil
+}
+
+func (db *YTFS) ObjectLegalHold(publicKey, bucketName, objectName string, versionID s3.VersionID) (*s3.ObjectLegalHold, error) {
+	lock, err := db.objectLock(publicKey, bucketName, objectName, versionID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.LegalHold {
+		return &s3.ObjectLegalHold{Status: "ON"}, nil
+	}
+	return &s3.ObjectLegalHold{Status: "OFF"}, nil
+}
+
+func (db *YTFS) PutObjectLegalHold(publicKey, bucketName, objectName string, versionID s3.VersionID, hold *s3.ObjectLegalHold) error {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return er
+	}
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return err
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	if errmsg := c.NewObjectAccessor().PutObjectLegalHold(bucketName, objectName, verid, hold.Status == "ON"); errmsg != nil {
+		return objectLockError(errmsg, bucketName, objectName)
+	}
+	return nil
+}
+
+func (db *YTFS) DeleteObjectBypass(publicKey, bucketName, objectName string, versionID s3.VersionID) (result s3.ObjectDeleteResult, rerr error) {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return result, er
+	}
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return result, err
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return result, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	if errmsg := c.NewObjectAccessor().DeleteObjectBypass(bucketName, objectName, verid, true); errmsg != nil && errmsg.Code != pkt.INVALID_OBJECT_NAME {
+		logrus.Errorf("[S3Delete]/%s/%s/%s,Err:%s\n", bucketName, objectName, versionID, pkt.ToError(errmsg))
+		return result, objectLockError(errmsg, bucketName, objectName)
+	}
+	result.VersionID = versionID
+	return result, nil
+}
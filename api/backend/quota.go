@@ -0,0 +1,33 @@
+package backend
+
+import (
+	"github.com/yottachain/YTCoreService/pkt"
+	"github.com/yottachain/YTCoreService/s3"
+)
+
+func (db *YTFS) BucketQuota(publicKey, bucketName string) (*s3.BucketQuota, error) {
+	_, meta, err := db.bucketMeta(publicKey, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := meta[pkt.BucketMetaQuota]
+	if !ok || s == "" {
+		return &s3.BucketQuota{}, nil
+	}
+	quota, err := pkt.ParseBucketQuota([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+	return &s3.BucketQuota{MaxSize: quota.MaxSize, MaxObjects: quota.MaxObjects}, nil
+}
+
+// uploadError maps a failed upload to the S3 error the client should see.
+func uploadError(errmsg *pkt.ErrorMessage) error {
+	switch errmsg.Code {
+	case pkt.BUCKET_QUOTA_EXCEEDED:
+		return s3.ErrQuotaExceeded
+	case pkt.OBJECT_LOCKED:
+		return s3.ErrObjectLocked
+	}
+	return pkt.ToError(errmsg)
+}
@@ -0,0 +1,45 @@
+package backend
+
+import (
+	"encoding/hex"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/api"
+	"github.com/yottachain/YTCoreService/env"
+	"github.com/yottachain/YTCoreService/s3"
+)
+
+func (db *YTFS) GetObjectWithCustomerKey(publicKey, bucketName, objectName string, versionID s3.VersionID, key *s3.CustomerKey, rangeRequest *s3.ObjectRangeRequest) (*s3.Object, error) {
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return nil, err
+	}
+	return db.getObjectV2(publicKey, bucketName, objectName, verid, key, rangeRequest, nil, s3.ListBucketPage{})
+}
+
+// MultipartUploadWithCustomerKey uploads the parts synchronously, so the key
+// never reaches the upload cache.
+func (db *YTFS) MultipartUploadWithCustomerKey(publicKey, bucketName, objectName string, partsPath []string, meta map[string]string, key *s3.CustomerKey) (result s3.PutObjectResult, etag []byte, err error) {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return result, nil, er
+	}
+	if env.Driver == "nas" {
+		return result, nil, s3.ErrNotImplemented
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return result, nil, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	header := objectHeader(meta)
+	header[s3.SSECustomerAlgorithm] = "AES256"
+	header[s3.SSECustomerKeyMD5] = key.KeyMD5
+	etag, errB := c.SyncUploadMultiPartFileWithKey(partsPath, bucketName, objectName, header, key.Key)
+	if errB != nil {
+		logrus.Errorf("[S3Upload]MultipartUpload /%s/%s,err:%s\n", bucketName, objectName, errB)
+		return result, nil, uploadError(errB)
+	}
+	logrus.Infof("[S3Upload]MultipartUpload /%s/%s,File upload success,etag:%s\n", bucketName, objectName, hex.EncodeToString(etag))
+	result.ETag = etag
+	return result, etag, nil
+}
@@ -0,0 +1,73 @@
+package backend
+
+import (
+	"github.com/yottachain/YTCoreService/api"
+	"github.com/yottachain/YTCoreService/pkt"
+	"github.com/yottachain/YTCoreService/s3"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func objectVersionID(versionID s3.VersionID) (primitive.ObjectID, error) {
+	if versionID == "" {
+		return primitive.NilObjectID, nil
+	}
+	id, err := primitive.ObjectIDFromHex(string(versionID))
+	if err != nil {
+		return primitive.NilObjectID, s3.ResourceError(s3.ErrNoSuchVersion, string(versionID))
+	}
+	return id, nil
+}
+
+func taggingError(errmsg *pkt.ErrorMessage, bucketName, objectName string) error {
+	switch errmsg.Code {
+	case pkt.INVALID_BUCKET_NAME:
+		return s3.BucketNotFound(bucketName)
+	case pkt.INVALID_OBJECT_NAME:
+		return s3.KeyNotFound(objectName)
+	default:
+		return pkt.ToError(errmsg)
+	}
+}
+
+func (db *YTFS) GetObjectTagging(publicKey, bucketName, objectName string, versionID s3.VersionID) (map[string]string, error) {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return nil, er
+	}
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return nil, err
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return nil, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	tags, errmsg := c.NewObjectAccessor().GetObjectTagging(bucketName, objectName, verid)
+	if errmsg != nil {
+		return nil, taggingError(errmsg, bucketName, objectName)
+	}
+	return tags, nil
+}
+
+func (db *YTFS) PutObjectTagging(publicKey, bucketName, objectName string, versionID s3.VersionID, tags map[string]string) error {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return er
+	}
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return err
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	if errmsg := c.NewObjectAccessor().PutObjectTagging(bucketName, objectName, verid, tags); errmsg != nil {
+		return taggingError(errmsg, bucketName, objectName)
+	}
+	return nil
+}
+
+func (db *YTFS) DeleteObjectTagging(publicKey, bucketName, objectName string, versionID s3.VersionID) error {
+	return db.PutObjectTagging(publicKey, bucketName, objectName, versionID, map[string]string{})
+}
@@ -13,7 +13,6 @@ import (
 	"github.com/yottachain/YTCoreService/api"
 	"github.com/yottachain/YTCoreService/api/cache"
 	"github.com/yottachain/YTCoreService/env"
-	"github.com/yottachain/YTCoreService/pkt"
 	"github.com/yottachain/YTCoreService/s3"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -28,20 +27,48 @@ func (db *YTFS) PutObject(publicKey, bucketName, objectName string, meta map[str
 	if c == nil {
 		return result, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
 	}
+	key, err := s3.CustomerKeyFromMeta(meta)
+	if err != nil {
+		return result, err
+	}
 	var hash []byte
 	var bts []byte
-	header := make(map[string]string)
-	if size >= int64(SyncFileMin) {
+	header := objectHeader(meta)
+	if key != nil {
+		if env.Driver == "nas" {
+			return result, s3.ErrNotImplemented
+		}
+		// Objects under a customer key are always streamed, the key must
+		// not reach the upload cache.
+		header[s3.SSECustomerAlgorithm] = "AES256"
+		header[s3.SSECustomerKeyMD5] = key.KeyMD5
+	}
+	if size > 0 && (key != nil || size >= int64(SyncFileMin) && streamUpload()) {
+		var ssekey []byte
+		if key != nil {
+			ssekey = key.Key
+		}
+		rd := &bodyReader{r: input}
+		md5bytes, erre := c.SyncUploadStreamWithKey(rd, size, bucketName, objectName, header, ssekey)
+		if erre != nil {
+			logrus.Errorf("[S3Upload]/%s/%s,UploadStream ERR: %s\n", bucketName, objectName, erre)
+			if rd.err != nil {
+				return result, rd.err
+			}
+			return result, uploadError(erre)
+		}
+		hash = md5bytes
+	} else if size >= int64(SyncFileMin) {
 		u1 := primitive.NewObjectID().Hex()
 		errw := writeCacheFile(env.GetS3Cache(), u1, input)
 		if errw != nil {
 			return result, errw
 		}
 		filePath := env.GetS3Cache() + u1
-		md5bytes, erre := c.UploadFile(filePath, bucketName, objectName)
+		md5bytes, erre := c.UploadFileWithMeta(filePath, bucketName, objectName, header)
 		if erre != nil {
 			logrus.Errorf("[S3Upload]/%s/%s,UploadFile ERR: %s\n", bucketName, objectName, erre)
-			return result, pkt.ToError(erre)
+			return result, uploadError(erre)
 		}
 		hash = md5bytes
 		if env.SyncMode == 0 {
@@ -61,12 +88,12 @@ func (db *YTFS) PutObject(publicKey, bucketName, objectName string, meta map[str
 			return result, err
 		}
 	}
-	if size < int64(SyncFileMin) {
+	if size < int64(SyncFileMin) && key == nil {
 		if size > 0 {
-			md5Hash, err1 := c.SyncUploadBytes(bts, bucketName, objectName)
+			md5Hash, err1 := c.SyncUploadBytesWithMeta(bts, bucketName, objectName, header)
 			if err1 != nil {
 				logrus.Errorf("[S3Upload]/%s/%s,SyncUploadBytes ERR:%s\n", bucketName, objectName, err1)
-				return result, pkt.ToError(err1)
+				return result, uploadError(err1)
 			}
 			hash = md5Hash
 		}
@@ -87,13 +114,48 @@ func (db *YTFS) PutObject(publicKey, bucketName, objectName string, meta map[str
 		errzero := c.NewObjectAccessor().CreateObject(bucketName, objectName, primitive.NewObjectID(), metadata2)
 		if errzero != nil {
 			logrus.Errorf("[S3Upload]/%s/%s,Save meta data ERR:%s\n", bucketName, objectName, errzero)
-			return result, pkt.ToError(errzero)
+			return result, uploadError(errzero)
 		}
 	}
 	logrus.Infof("[S3Upload]/%s/%sFile upload success,file md5 value : %s\n", bucketName, objectName, hex.EncodeToString(hash[:]))
+	result.ETag = hash
 	return result, nil
 }
 
+// streamUpload reports whether PutObject may upload the request body as it
+// arrives. Async mode and the nas driver still stage the object in the cache.
+func streamUpload() bool {
+	return StreamUpload && env.SyncMode == 0 && env.Driver != "nas"
+}
+
+// bodyReader keeps the request body error, e.g. a bad digest, so it is not
+// reported as a codec error.
+type bodyReader struct {
+	r   io.Reader
+	err error
+}
+
+func (b *bodyReader) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	if err != nil && err != io.EOF {
+		b.err = err
+	}
+	return n, err
+}
+
+// objectHeader picks the user metadata and standard headers that are kept
+// with the object and returned on GET/HEAD, plus the object lock headers
+// the SN applies to the new version.
+func objectHeader(meta map[string]string) map[string]string {
+	header := make(map[string]string)
+	for k, v := range meta {
+		if s3.IsObjectMetadata(k) || s3.IsObjectLockHeader(k) {
+			header[k] = v
+		}
+	}
+	return header
+}
+
 func writeCacheFile(directory, fileName string, input io.Reader) error {
 	s, err := os.Stat(directory)
 	if err != nil {
@@ -144,10 +206,10 @@ func (db *YTFS) MultipartUpload(publicKey, bucketName, objectName string, partsP
 	if c == nil {
 		return result, nil, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
 	}
-	md5Bytes, errB := c.UploadMultiPartFile(partsPath, bucketName, objectName)
+	md5Bytes, errB := c.UploadMultiPartFileWithMeta(partsPath, bucketName, objectName, objectHeader(meta))
 	if errB != nil {
 		logrus.Errorf("[S3Upload]MultipartUpload /%s/%s,err:%s\n", bucketName, objectName, errB)
-		return
+		return result, nil, uploadError(errB)
 	}
 	logrus.Infof("[S3Upload]MultipartUpload /%s/%s,File upload success,file md5 value : %s\n", bucketName, objectName, hex.EncodeToString(md5Bytes[:]))
 	return result, md5Bytes, nil
@@ -0,0 +1,145 @@
+package backend
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/api"
+	"github.com/yottachain/YTCoreService/pkt"
+	"github.com/yottachain/YTCoreService/s3"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (db *YTFS) VersioningConfiguration(publicKey, bucketName string) (s3.VersioningConfiguration, error) {
+	_, meta, err := db.bucketMeta(publicKey, bucketName)
+	if err != nil {
+		return s3.VersioningConfiguration{}, err
+	}
+	return s3.VersioningConfiguration{Status: s3.VersioningStatus(meta[pkt.BucketMetaVersioning])}, nil
+}
+
+// SetVersioningConfiguration only accepts Enabled: the SN adds a version on
+// every write and has no null version an unversioned write could replace,
+// so versioning cannot be suspended.
+func (db *YTFS) SetVersioningConfiguration(publicKey, bucketName string, v s3.VersioningConfiguration) error {
+	if v.MFADelete == s3.MFADeleteEnabled {
+		return s3.ErrNotImplemented
+	}
+	if v.Status != s3.VersioningEnabled {
+		return s3.ErrorMessage(s3.ErrNotImplemented, "Versioning can not be suspended, every write is kept as a version")
+	}
+	return db.setBucketMeta(publicKey, bucketName, pkt.BucketMetaVersioning, string(v.Status))
+}
+
+func (db *YTFS) GetObjectVersion(publicKey, bucketName, objectName string, versionID s3.VersionID, rangeRequest *s3.ObjectRangeRequest) (*s3.Object, error) {
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return nil, err
+	}
+	return db.getObjectV2(publicKey, bucketName, objectName, verid, nil, rangeRequest, nil, s3.ListBucketPage{})
+}
+
+func (db *YTFS) HeadObjectVersion(publicKey, bucketName, objectName string, versionID s3.VersionID) (*s3.Object, error) {
+	return db.GetObjectVersion(publicKey, bucketName, objectName, versionID, nil)
+}
+
+// DeleteObjectVersion removes a single version; the previous version, if
+// any, becomes the current one. The SN keeps no delete markers.
+func (db *YTFS) DeleteObjectVersion(publicKey, bucketName, objectName string, versionID s3.VersionID) (result s3.ObjectDeleteResult, rerr error) {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return result, er
+	}
+	verid, err := objectVersionID(versionID)
+	if err != nil {
+		return result, err
+	}
+	if verid == primitive.NilObjectID {
+		return result, s3.ResourceError(s3.ErrNoSuchVersion, string(versionID))
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return result, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	if errmsg := c.NewObjectAccessor().DeleteObject(bucketName, objectName, verid); errmsg != nil && errmsg.Code != pkt.INVALID_OBJECT_NAME {
+		logrus.Errorf("[S3Delete]/%s/%s/%s,Err:%s\n", bucketName, objectName, versionID, pkt.ToError(errmsg))
+		return result, objectLockError(errmsg, bucketName, objectName)
+	}
+	result.VersionID = versionID
+	return result, nil
+}
+
+// ListBucketVersions pages through the SN in storage order, so versions of a
+// key are listed oldest first.
+func (db *YTFS) ListBucketVersions(publicKey, bucketName string, prefix *s3.Prefix, page *s3.ListBucketVersionsPage) (*s3.ListBucketVersionsResult, error) {
+	_, er := db.getBucket(publicKey, bucketName)
+	if er != nil {
+		return nil, er
+	}
+	c := api.GetClient(publicKey)
+	if c == nil {
+		return nil, s3.ResourceError(s3.ErrInvalidAccessKeyID, "YTA"+publicKey)
+	}
+	if page.MaxKeys <= 0 || page.MaxKeys > s3.DefaultBucketVersionKeys {
+		page.MaxKeys = s3.DefaultBucketVersionKeys
+	}
+	nVerid := primitive.NilObjectID
+	if page.HasVersionIDMarker {
+		var err error
+		if nVerid, err = objectVersionID(page.VersionIDMarker); err != nil {
+			return nil, err
+		}
+	}
+	pfix := ""
+	if prefix.HasPrefix {
+		pfix = prefix.Prefix
+	}
+	items, errmsg := c.NewObjectAccessor().ListObject(bucketName, page.KeyMarker, pfix, true, nVerid, uint32(page.MaxKeys))
+	if errmsg != nil {
+		return nil, pkt.ToError(errmsg)
+	}
+	result := s3.NewListBucketVersionsResult(bucketName, prefix, page)
+	owner := &s3.UserInfo{ID: c.Username, DisplayName: c.Username}
+	var count int64
+	var match s3.PrefixMatch
+	for _, v := range items {
+		if page.HasKeyMarker && !page.HasVersionIDMarker && v.FileName == page.KeyMarker {
+			continue
+		}
+		if count >= page.MaxKeys {
+			break
+		}
+		count++
+		result.NextKeyMarker = v.FileName
+		result.NextVersionIDMarker = s3.VersionID(v.VersionId.Hex())
+		if !prefix.Match(v.FileName, &match) {
+			continue
+		}
+		if match.CommonPrefix {
+			result.AddPrefix(match.MatchedPart)
+			continue
+		}
+		meta, err := api.BytesToFileMetaMap(v.Meta, primitive.ObjectID{})
+		if err != nil {
+			logrus.Warnf("[ListVersions]ERR meta,filename:%s\n", v.FileName)
+			continue
+		}
+		meta["x-amz-meta-s3b-last-modified"] = time.Unix(v.VersionId.Timestamp().Unix(), 0).Format("20060102T150405Z")
+		content := GetContentByMeta(meta)
+		result.Versions = append(result.Versions, &s3.Version{
+			Key:          v.FileName,
+			VersionID:    s3.VersionID(v.VersionId.Hex()),
+			IsLatest:     v.Latest,
+			LastModified: content.LastModified,
+			Size:         content.Size,
+			ETag:         content.ETag,
+			Owner:        owner,
+		})
+	}
+	result.IsTruncated = int64(len(items)) >= page.MaxKeys
+	if !result.IsTruncated {
+		result.NextKeyMarker = ""
+		result.NextVersionIDMarker = ""
+	}
+	return result, nil
+}
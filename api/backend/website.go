@@ -0,0 +1,37 @@
+package backend
+
+import (
+	"encoding/xml"
+
+	"github.com/yottachain/YTCoreService/s3"
+)
+
+const bucketMetaWebsite = "website"
+
+func (db *YTFS) WebsiteConfiguration(publicKey, bucketName string) (*s3.WebsiteConfiguration, error) {
+	_, meta, err := db.bucketMeta(publicKey, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := meta[bucketMetaWebsite]
+	if !ok || s == "" {
+		return nil, s3.ResourceError(s3.ErrNoSuchWebsiteConfiguration, bucketName)
+	}
+	config := &s3.WebsiteConfiguration{}
+	if err := xml.Unmarshal([]byte(s), config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (db *YTFS) SetWebsiteConfiguration(publicKey, bucketName string, config *s3.WebsiteConfiguration) error {
+	bs, err := xml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	return db.setBucketMeta(publicKey, bucketName, bucketMetaWebsite, string(bs))
+}
+
+func (db *YTFS) DeleteWebsiteConfiguration(publicKey, bucketName string) error {
+	return db.deleteBucketMeta(publicKey, bucketName, bucketMetaWebsite)
+}
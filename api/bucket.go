@@ -59,6 +59,35 @@ func (buck *BucketAccessor) UpdateBucket(name string, meta []byte) *pkt.ErrorMes
 	}
 }
 
+// SetBucketMeta sets one key of the bucket meta without rewriting the
+// others, so concurrent changes of different settings are all kept.
+func (buck *BucketAccessor) SetBucketMeta(name, key, value string) *pkt.ErrorMessage {
+	return buck.setBucketMeta(name, key, &value)
+}
+
+func (buck *BucketAccessor) DeleteBucketMeta(name, key string) *pkt.ErrorMessage {
+	return buck.setBucketMeta(name, key, nil)
+}
+
+func (buck *BucketAccessor) setBucketMeta(name, key string, value *string) *pkt.ErrorMessage {
+	req := &pkt.SetBucketMetaReqV2{
+		UserId:     &buck.UClient.UserId,
+		SignData:   &buck.UClient.SignKey.Sign,
+		KeyNumber:  &buck.UClient.SignKey.KeyNumber,
+		BucketName: &name,
+		Key:        &key,
+		Value:      value,
+	}
+	_, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[SetBucketMeta][%d][%s][%s]ERR:%s\n", buck.UClient.UserId, name, key, pkt.ToError(errmsg))
+		return errmsg
+	} else {
+		logrus.Infof("[SetBucketMeta][%d][%s][%s]OK.\n", buck.UClient.UserId, name, key)
+		return nil
+	}
+}
+
 func (buck *BucketAccessor) ListBucket() ([]string, *pkt.ErrorMessage) {
 	req := &pkt.ListBucketReqV2{
 		UserId:    &buck.UClient.UserId,
@@ -50,6 +50,7 @@ type Value struct {
 	Md5    []byte
 	Path   []string
 	Data   []byte
+	Meta   []byte
 }
 
 func (v *Value) PathString() string {
@@ -100,6 +101,11 @@ func NewValue(data []byte) *Value {
 		bytebuf.Read(bs)
 		v.Path[i] = string(bs)
 	}
+	size = 0
+	if binary.Read(bytebuf, binary.BigEndian, &size) == nil && size > 0 {
+		v.Meta = make([]byte, size)
+		bytebuf.Read(v.Meta)
+	}
 	return v
 }
 
@@ -130,6 +136,10 @@ func (self *Value) ToBytes() []byte {
 		binary.Write(bytebuf, binary.BigEndian, ii)
 		bytebuf.Write(bs)
 	}
+	if len(self.Meta) > 0 {
+		binary.Write(bytebuf, binary.BigEndian, int32(len(self.Meta)))
+		bytebuf.Write(self.Meta)
+	}
 	return bytebuf.Bytes()
 }
 
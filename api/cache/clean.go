@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/boltdb/bolt"
 	"github.com/sirupsen/logrus"
 	"github.com/yottachain/YTCoreService/env"
 )
@@ -39,6 +40,11 @@ const FileExpiredTime = 60 * 60 * 24
 
 var LastCleanTime int64 = 0
 
+// KeepDirs returns the dirs Clear must leave alone however old their files
+// are, such as those of multipart uploads still in progress, which are
+// expired by the S3 server instead.
+var KeepDirs func() map[string]bool
+
 func Clear() {
 	if time.Now().Unix()-LastCleanTime < FileExpiredTime {
 		return
@@ -48,8 +54,15 @@ func Clear() {
 	if err != nil {
 		return
 	}
+	keep := make(map[string]bool)
+	if KeepDirs != nil {
+		keep = KeepDirs()
+	}
 	for _, fi := range rd {
 		if fi.IsDir() {
+			if keep[path.Clean(env.GetS3Cache()+fi.Name())] {
+				continue
+			}
 			deleteDir(env.GetS3Cache(), fi)
 		} else {
 			deleteFile(env.GetS3Cache(), fi)
@@ -102,3 +115,64 @@ func deleteDir(parent string, dir os.FileInfo) bool {
 		return false
 	}
 }
+
+func cachedDirs() map[string]bool {
+	dirs := make(map[string]bool)
+	if CacheDB == nil {
+		return dirs
+	}
+	CacheDB.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(TempBuck)
+		cur := b.Cursor()
+		for k, v := cur.First(); k != nil; k, v = cur.Next() {
+			for _, p := range NewValue(v).Path {
+				dirs[path.Clean(path.Dir(strings.ReplaceAll(p, "\\", "/")))] = true
+			}
+		}
+		return nil
+	})
+	return dirs
+}
+
+func ClearOrphanDirs(expired time.Duration, active map[string]bool) (int, int64) {
+	rd, err := ioutil.ReadDir(env.GetS3Cache())
+	if err != nil {
+		return 0, 0
+	}
+	cached := cachedDirs()
+	count, size := 0, int64(0)
+	for _, fi := range rd {
+		if !fi.IsDir() || time.Since(fi.ModTime()) < expired {
+			continue
+		}
+		dir := path.Clean(env.GetS3Cache() + fi.Name())
+		if active[dir] || cached[dir] {
+			continue
+		}
+		n := DirSize(dir)
+		if err := os.RemoveAll(dir); err != nil {
+			logrus.Errorf("[Cache]Delete orphan dir %s ERR:%s\n", dir, err)
+			continue
+		}
+		logrus.Infof("[Cache]Delete orphan dir %s,size:%d\n", dir, n)
+		count++
+		size = size + n
+	}
+	return count, size
+}
+
+func DirSize(dir string) int64 {
+	var size int64
+	rd, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return 0
+	}
+	for _, fi := range rd {
+		if fi.IsDir() {
+			size = size + DirSize(dir+"/"+fi.Name())
+		} else {
+			size = size + fi.Size()
+		}
+	}
+	return size
+}
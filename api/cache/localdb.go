@@ -12,6 +12,9 @@ var cachedbname = "cache.db"
 var objdbname = "object.db"
 var TempBuck = []byte("tmpobject")
 var SyncBuck = []byte("syncobject")
+var UploadBuck = []byte("s3upload")
+var OwnerBuck = []byte("s3owner")
+var WebsiteBuck = []byte("s3website")
 var CacheDB *bolt.DB
 var ObjectDB *bolt.DB
 
@@ -24,16 +27,16 @@ func InitDB() error {
 		CacheDB = dbc
 	}
 	err = CacheDB.Update(func(tx *bolt.Tx) error {
-		b, err1 := tx.CreateBucket(TempBuck)
-		if err1 != nil {
-			b = tx.Bucket(TempBuck)
-			if b == nil {
-				err1 = errors.New("CreateBucket err.")
-			} else {
-				err1 = nil
+		for _, name := range [][]byte{TempBuck, UploadBuck, OwnerBuck, WebsiteBuck} {
+			b, err1 := tx.CreateBucket(name)
+			if err1 != nil {
+				b = tx.Bucket(name)
+				if b == nil {
+					return errors.New("CreateBucket err.")
+				}
 			}
 		}
-		return err1
+		return migrateOwners(tx)
 	})
 	if err != nil {
 		return err
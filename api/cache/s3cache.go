@@ -1,6 +1,148 @@
 package cache
 
+import (
+	"bytes"
+	"errors"
+
+	"github.com/boltdb/bolt"
+)
+
 type SingleFile struct {
 	uploadId string
 	length   int64
 }
+
+func PutUpload(id string, data []byte) error {
+	if CacheDB == nil {
+		return nil
+	}
+	return CacheDB.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(UploadBuck)
+		return b.Put([]byte(id), data)
+	})
+}
+
+func DeleteUpload(id string) error {
+	if CacheDB == nil {
+		return nil
+	}
+	return CacheDB.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(UploadBuck)
+		return b.Delete([]byte(id))
+	})
+}
+
+func ListUploads(fn func(id string, data []byte)) error {
+	if CacheDB == nil {
+		return nil
+	}
+	return CacheDB.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(UploadBuck)
+		cur := b.Cursor()
+		for k, v := cur.First(); k != nil; k, v = cur.Next() {
+			fn(string(k), v)
+		}
+		return nil
+	})
+}
+
+var ErrBucketOwned = errors.New("bucket name is shared by another owner")
+
+// Owner records are keyed by bucket and owner. Bucket names are only unique
+// per user, so the first owner to share a name keeps it until the record is
+// deleted by that owner.
+func ownerKey(bucket, accesskey string) []byte {
+	return []byte(bucket + "\x00" + accesskey)
+}
+
+func firstOwner(b *bolt.Bucket, bucket string) string {
+	prefix := []byte(bucket + "\x00")
+	k, v := b.Cursor().Seek(prefix)
+	if k != nil && bytes.HasPrefix(k, prefix) {
+		return string(v)
+	}
+	return ""
+}
+
+func PutBucketOwner(bucket, accesskey string) error {
+	return putOwner(OwnerBuck, bucket, accesskey)
+}
+
+func GetBucketOwner(bucket string) string {
+	return getOwner(OwnerBuck, bucket)
+}
+
+func DeleteBucketOwner(bucket, accesskey string) error {
+	return deleteOwner(OwnerBuck, bucket, accesskey)
+}
+
+// Website owners are kept apart from sharing owners: the website of a name
+// belongs to whoever configured it first.
+func PutWebsiteOwner(bucket, accesskey string) error {
+	return putOwner(WebsiteBuck, bucket, accesskey)
+}
+
+func GetWebsiteOwner(bucket string) string {
+	return getOwner(WebsiteBuck, bucket)
+}
+
+func DeleteWebsiteOwner(bucket, accesskey string) error {
+	return deleteOwner(WebsiteBuck, bucket, accesskey)
+}
+
+func putOwner(name []byte, bucket, accesskey string) error {
+	if CacheDB == nil {
+		return nil
+	}
+	return CacheDB.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(name)
+		if owner := firstOwner(b, bucket); owner != "" && owner != accesskey {
+			return ErrBucketOwned
+		}
+		return b.Put(ownerKey(bucket, accesskey), []byte(accesskey))
+	})
+}
+
+func getOwner(name []byte, bucket string) string {
+	if CacheDB == nil {
+		return ""
+	}
+	var owner string
+	CacheDB.View(func(tx *bolt.Tx) error {
+		owner = firstOwner(tx.Bucket(name), bucket)
+		return nil
+	})
+	return owner
+}
+
+func deleteOwner(name []byte, bucket, accesskey string) error {
+	if CacheDB == nil {
+		return nil
+	}
+	return CacheDB.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(name).Delete(ownerKey(bucket, accesskey))
+	})
+}
+
+// migrateOwners rewrites records kept by bucket name alone into owner keys.
+func migrateOwners(tx *bolt.Tx) error {
+	b := tx.Bucket(OwnerBuck)
+	legacy := make(map[string]string)
+	b.ForEach(func(k, v []byte) error {
+		if !bytes.Contains(k, []byte{0}) {
+			legacy[string(k)] = string(v)
+		}
+		return nil
+	})
+	for bucket, owner := range legacy {
+		if err := b.Delete([]byte(bucket)); err != nil {
+			return err
+		}
+		if firstOwner(b, bucket) == "" {
+			if err := b.Put(ownerKey(bucket, owner), []byte(owner)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
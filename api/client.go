@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/md5"
 	"crypto/sha256"
+	"io"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -46,12 +47,11 @@ func (c *Client) GetProgress(bucketname, key string) int32 {
 }
 
 func (c *Client) SyncUploadMultiPartFile(path []string, bucketname, key string) ([]byte, *pkt.ErrorMessage) {
-	var up UploadObjectBase
-	if env.Driver == "nas" {
-		up = NewUploadObjectToDisk(c, bucketname, key)
-	} else {
-		up = NewUploadObject(c)
-	}
+	return c.SyncUploadMultiPartFileWithMeta(path, bucketname, key, nil)
+}
+
+func (c *Client) SyncUploadMultiPartFileWithMeta(path []string, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
+	up := newBucketUploadObject(c, bucketname, key)
 	PutUploadObject(int32(c.UserId), bucketname, key, up)
 	defer func() {
 		DelUploadObject(int32(c.UserId), bucketname, key)
@@ -62,7 +62,7 @@ func (c *Client) SyncUploadMultiPartFile(path []string, bucketname, key string)
 		return nil, err
 	}
 	if r, ok := up.(*UploadObject); ok {
-		meta := MetaTobytes(up.GetLength(), up.GetMD5())
+		meta := HeaderMetaTobytes(up.GetLength(), up.GetMD5(), header)
 		err = c.NewObjectAccessor().CreateObject(bucketname, key, r.VNU, meta)
 		if err != nil {
 			logrus.Errorf("[SyncUploadMultiPartFile]WriteMeta ERR:%s,%s/%s\n", pkt.ToError(err), bucketname, key)
@@ -74,25 +74,55 @@ func (c *Client) SyncUploadMultiPartFile(path []string, bucketname, key string)
 	return up.GetMD5(), nil
 }
 
+// SyncUploadMultiPartFileWithKey uploads the parts of a multipart upload
+// with the block keys additionally wrapped by a customer provided key and
+// returns the keyed ETag of the object.
+func (c *Client) SyncUploadMultiPartFileWithKey(path []string, bucketname, key string, header map[string]string, ssekey []byte) ([]byte, *pkt.ErrorMessage) {
+	up := NewUploadObject(c)
+	up.Bucket = bucketname
+	up.SSEKey = ssekey
+	PutUploadObject(int32(c.UserId), bucketname, key, up)
+	defer func() {
+		DelUploadObject(int32(c.UserId), bucketname, key)
+		cache.Delete(path)
+	}()
+	err := up.UploadMultiFile(path)
+	if err != nil {
+		return nil, err
+	}
+	meta := HeaderMetaTobytes(up.GetLength(), up.ETag(), header)
+	err = c.NewObjectAccessor().CreateObject(bucketname, key, up.VNU, meta)
+	if err != nil {
+		logrus.Errorf("[SyncUploadMultiPartFile]WriteMeta ERR:%s,%s/%s\n", pkt.ToError(err), bucketname, key)
+		return nil, err
+	} else {
+		logrus.Infof("[SyncUploadMultiPartFile]WriteMeta OK,%s/%s\n", bucketname, key)
+	}
+	return up.ETag(), nil
+}
+
 func (c *Client) UploadMultiPartFile(path []string, bucketname, key string) ([]byte, *pkt.ErrorMessage) {
+	return c.UploadMultiPartFileWithMeta(path, bucketname, key, nil)
+}
+
+func (c *Client) UploadMultiPartFileWithMeta(path []string, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
 	if env.SyncMode == 0 {
-		return c.SyncUploadMultiPartFile(path, bucketname, key)
+		return c.SyncUploadMultiPartFileWithMeta(path, bucketname, key, header)
 	}
-	md5, err := UploadMultiPartFile(int32(c.UserId), path, bucketname, key)
+	md5, err := UploadMultiPartFile(int32(c.UserId), path, bucketname, key, header)
 	if err != nil && err.Code == pkt.CACHE_FULL {
-		return c.SyncUploadMultiPartFile(path, bucketname, key)
+		return c.SyncUploadMultiPartFileWithMeta(path, bucketname, key, header)
 	} else {
 		return md5, err
 	}
 }
 
 func (c *Client) SyncUploadBytes(data []byte, bucketname, key string) ([]byte, *pkt.ErrorMessage) {
-	var up UploadObjectBase
-	if env.Driver == "nas" {
-		up = NewUploadObjectToDisk(c, bucketname, key)
-	} else {
-		up = NewUploadObject(c)
-	}
+	return c.SyncUploadBytesWithMeta(data, bucketname, key, nil)
+}
+
+func (c *Client) SyncUploadBytesWithMeta(data []byte, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
+	up := newBucketUploadObject(c, bucketname, key)
 	PutUploadObject(int32(c.UserId), bucketname, key, up)
 	defer func() {
 		DelUploadObject(int32(c.UserId), bucketname, key)
@@ -102,7 +132,7 @@ func (c *Client) SyncUploadBytes(data []byte, bucketname, key string) ([]byte, *
 		return nil, err
 	}
 	if r, ok := up.(*UploadObject); ok {
-		meta := MetaTobytes(up.GetLength(), up.GetMD5())
+		meta := HeaderMetaTobytes(up.GetLength(), up.GetMD5(), header)
 		err = c.NewObjectAccessor().CreateObject(bucketname, key, r.VNU, meta)
 		if err != nil {
 			logrus.Errorf("[SyncUploadBytes]WriteMeta ERR:%s,%s/%s\n", pkt.ToError(err), bucketname, key)
@@ -115,12 +145,16 @@ func (c *Client) SyncUploadBytes(data []byte, bucketname, key string) ([]byte, *
 }
 
 func (c *Client) UploadBytes(data []byte, bucketname, key string) ([]byte, *pkt.ErrorMessage) {
+	return c.UploadBytesWithMeta(data, bucketname, key, nil)
+}
+
+func (c *Client) UploadBytesWithMeta(data []byte, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
 	if env.SyncMode == 0 {
-		return c.SyncUploadBytes(data, bucketname, key)
+		return c.SyncUploadBytesWithMeta(data, bucketname, key, header)
 	}
-	md5, err := UploadBytesFile(int32(c.UserId), data, bucketname, key)
+	md5, err := UploadBytesFile(int32(c.UserId), data, bucketname, key, header)
 	if err != nil && err.Code == pkt.CACHE_FULL {
-		return c.SyncUploadBytes(data, bucketname, key)
+		return c.SyncUploadBytesWithMeta(data, bucketname, key, header)
 	} else {
 		return md5, err
 	}
@@ -140,12 +174,11 @@ func (c *Client) UploadZeroFile(bucketname, key string) ([]byte, *pkt.ErrorMessa
 }
 
 func (c *Client) SyncUploadFile(path string, bucketname, key string) ([]byte, *pkt.ErrorMessage) {
-	var up UploadObjectBase
-	if env.Driver == "nas" {
-		up = NewUploadObjectToDisk(c, bucketname, key)
-	} else {
-		up = NewUploadObject(c)
-	}
+	return c.SyncUploadFileWithMeta(path, bucketname, key, nil)
+}
+
+func (c *Client) SyncUploadFileWithMeta(path string, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
+	up := newBucketUploadObject(c, bucketname, key)
 	PutUploadObject(int32(c.UserId), bucketname, key, up)
 	defer func() {
 		DelUploadObject(int32(c.UserId), bucketname, key)
@@ -156,7 +189,7 @@ func (c *Client) SyncUploadFile(path string, bucketname, key string) ([]byte, *p
 		return nil, err
 	}
 	if r, ok := up.(*UploadObject); ok {
-		meta := MetaTobytes(up.GetLength(), up.GetMD5())
+		meta := HeaderMetaTobytes(up.GetLength(), up.GetMD5(), header)
 		err = c.NewObjectAccessor().CreateObject(bucketname, key, r.VNU, meta)
 		if err != nil {
 			logrus.Errorf("[SyncUploadFile]WriteMeta ERR:%s,%s/%s\n", pkt.ToError(err), bucketname, key)
@@ -169,17 +202,53 @@ func (c *Client) SyncUploadFile(path string, bucketname, key string) ([]byte, *p
 }
 
 func (c *Client) UploadFile(path string, bucketname, key string) ([]byte, *pkt.ErrorMessage) {
+	return c.UploadFileWithMeta(path, bucketname, key, nil)
+}
+
+func (c *Client) UploadFileWithMeta(path string, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
 	if env.SyncMode == 0 {
-		return c.SyncUploadFile(path, bucketname, key)
+		return c.SyncUploadFileWithMeta(path, bucketname, key, header)
 	}
-	md5, err := UploadSingleFile(int32(c.UserId), path, bucketname, key)
+	md5, err := UploadSingleFile(int32(c.UserId), path, bucketname, key, header)
 	if err != nil && err.Code == pkt.CACHE_FULL {
-		return c.SyncUploadFile(path, bucketname, key)
+		return c.SyncUploadFileWithMeta(path, bucketname, key, header)
 	} else {
 		return md5, err
 	}
 }
 
+// SyncUploadStreamWithMeta uploads size bytes read from r without staging
+// them in the cache.
+func (c *Client) SyncUploadStreamWithMeta(r io.Reader, size int64, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
+	return c.SyncUploadStreamWithKey(r, size, bucketname, key, header, nil)
+}
+
+// SyncUploadStreamWithKey is SyncUploadStreamWithMeta with the block keys
+// additionally wrapped by a customer provided key. It returns the ETag of
+// the object, which is keyed by ssekey if given.
+func (c *Client) SyncUploadStreamWithKey(r io.Reader, size int64, bucketname, key string, header map[string]string, ssekey []byte) ([]byte, *pkt.ErrorMessage) {
+	up := NewUploadObject(c)
+	up.Bucket = bucketname
+	up.SSEKey = ssekey
+	PutUploadObject(int32(c.UserId), bucketname, key, up)
+	defer func() {
+		DelUploadObject(int32(c.UserId), bucketname, key)
+	}()
+	err := up.UploadStream(r, size)
+	if err != nil {
+		return nil, err
+	}
+	meta := HeaderMetaTobytes(up.GetLength(), up.ETag(), header)
+	err = c.NewObjectAccessor().CreateObject(bucketname, key, up.VNU, meta)
+	if err != nil {
+		logrus.Errorf("[SyncUploadStream]WriteMeta ERR:%s,%s/%s\n", pkt.ToError(err), bucketname, key)
+		return nil, err
+	} else {
+		logrus.Infof("[SyncUploadStream]WriteMeta OK,%s/%s\n", bucketname, key)
+	}
+	return up.ETag(), nil
+}
+
 func FlushCache() {
 	for {
 		if cache.GetCacheSize() > 0 {
@@ -218,6 +287,18 @@ func (c *Client) NewDownloadFile(bucketName, filename string, version primitive.
 	}
 }
 
+// NewDownloadFileWithKey opens an object stored under a customer provided
+// key; SetCustomerKey unwraps its block keys once the key is checked.
+func (c *Client) NewDownloadFileWithKey(bucketName, filename string, version primitive.ObjectID, ssekey []byte) (*DownloadObject, *pkt.ErrorMessage) {
+	do := &DownloadObject{UClient: c, Progress: &DownProgress{}, SSEKey: ssekey}
+	err := do.InitByKey(bucketName, filename, version)
+	if err != nil {
+		return nil, err
+	} else {
+		return do, nil
+	}
+}
+
 func (c *Client) UploadPreEncode(bucketname, objectname string) *UploadObjectToDisk {
 	return NewUploadObjectToDisk(c, bucketname, objectname)
 }
@@ -275,6 +275,7 @@ func (down DownloadBlock) aesDecode(b *codec.EncryptedBlock) (*codec.PlainBlock,
 	if down.Path != "" {
 		b.Save(down.Path + "block.enc")
 	}
+	b.Format = down.Ref.Format
 	dec := codec.NewBlockAESDecryptor(b)
 	pb, err := dec.Decrypt()
 	if err != nil {
@@ -3,6 +3,7 @@ package api
 import (
 	"crypto/md5"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 	"strings"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/mr-tron/base58/base58"
 	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/codec"
 	"github.com/yottachain/YTCoreService/net"
 	"github.com/yottachain/YTCoreService/pkt"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -25,6 +27,7 @@ type DownloadObject struct {
 	Progress *DownProgress
 	Meta     []byte
 	VHW      []byte
+	SSEKey   []byte
 }
 
 type DownProgress struct {
@@ -56,12 +59,32 @@ func (down *DownloadObject) GetProgress() int32 {
 	return (down.Progress.ReadBlockNum - 1) * 100 / down.Progress.TotalBlockNum
 }
 
+// SetCustomerKey unwraps the block keys of an object stored with a customer
+// provided key.
+func (down *DownloadObject) SetCustomerKey(ssekey []byte) *pkt.ErrorMessage {
+	rss := make([][]byte, len(down.REFS))
+	for _, ref := range down.REFS {
+		id := int(ref.Id) & 0xFFFF
+		if id >= len(rss) || len(ref.KEU) != 32 {
+			return pkt.NewErrorMsg(pkt.SERVER_ERROR, "Invalid refer")
+		}
+		k, ok := down.UClient.KeyMap[uint32(ref.KeyNumber)]
+		if !ok {
+			return pkt.NewErrorMsg(pkt.PRIKEY_NOT_EXIST, fmt.Sprintf("The user did not enter a private key with number %d", ref.KeyNumber))
+		}
+		rss[id] = codec.ECBDecryptNoPad(codec.ECBDecryptNoPad(ref.KEU, ssekey), k.AESKey)
+	}
+	down.RSS = rss
+	return nil
+}
+
 func (down *DownloadObject) InitByVHW(vhw []byte) *pkt.ErrorMessage {
 	req := &pkt.DownloadObjectInitReqV2{
 		UserId:    &down.UClient.UserId,
 		SignData:  &down.UClient.SignKey.Sign,
 		KeyNumber: &down.UClient.SignKey.KeyNumber,
 		VHW:       vhw,
+		SseKeyMD5: down.sseKeyMD5(),
 	}
 	return down.init(req, base58.Encode(vhw))
 }
@@ -81,9 +104,20 @@ func (down *DownloadObject) InitByKey(bucketName, filename string, version primi
 		req.Versionid = v
 		key = key + "/" + version.Hex()
 	}
+	req.SseKeyMD5 = down.sseKeyMD5()
 	return down.init(req, key)
 }
 
+// sseKeyMD5 identifies the customer key to the SN, which withholds objects
+// stored under a customer key from requests without it.
+func (down *DownloadObject) sseKeyMD5() []byte {
+	if down.SSEKey == nil {
+		return nil
+	}
+	sum := md5.Sum(down.SSEKey)
+	return sum[:]
+}
+
 func (down *DownloadObject) init(req proto.Message, key string) *pkt.ErrorMessage {
 	startTime := time.Now()
 	resp, errmsg := net.RequestSN(req)
@@ -186,6 +220,7 @@ func (down *DownloadObject) SaveToFile(path string) ([]byte, error) {
 	}
 	defer f.Close()
 	read := NewDownLoadReader(down, 0, down.Length)
+	defer read.Close()
 	readbuf := make([]byte, 8192)
 	md5Digest := md5.New()
 	for {
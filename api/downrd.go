@@ -5,9 +5,11 @@ import (
 	"errors"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 
 	"github.com/yottachain/YTCoreService/codec"
+	"github.com/yottachain/YTCoreService/env"
 	"github.com/yottachain/YTCoreService/pkt"
 )
 
@@ -27,6 +29,35 @@ type DownLoadReader struct {
 	referIndex int32
 	bin        io.Reader
 	KSS        [][]byte
+	offsets    []int64
+	ahead      map[int32]*prefetchBlock
+	nextFetch  int32
+}
+
+// PREFETCH_CH holds one token per block that may be buffered by read-ahead,
+// shared by all readers so the window stays within downloadPrefetchMem.
+var PREFETCH_CH chan int
+
+func InitPrefetchPool() {
+	num := int64(env.DownloadPrefetchMem) * 1024 * 1024 / env.Default_Block_Size
+	if num < 1 {
+		num = 1
+	}
+	PREFETCH_CH = make(chan int, num)
+	for ii := int64(0); ii < num; ii++ {
+		PREFETCH_CH <- 1
+	}
+}
+
+type prefetchBlock struct {
+	done  chan struct{}
+	block *codec.PlainBlock
+	err   *pkt.ErrorMessage
+}
+
+func (pb *prefetchBlock) release() {
+	<-pb.done
+	PREFETCH_CH <- 1
 }
 
 func NewDownLoadReader(dobj *DownloadObject, st, ed int64) *DownLoadReader {
@@ -39,9 +70,33 @@ func NewDownLoadReader(dobj *DownloadObject, st, ed int64) *DownLoadReader {
 	}
 	reader.KSS = dobj.RSS
 	reader.Refs = refmap
+	reader.offsets = blockOffsets(refmap)
+	reader.seek(reader.offsets)
+	reader.ahead = make(map[int32]*prefetchBlock)
 	return reader
 }
 
+// blockOffsets returns the object offset of each block, in block order.
+func blockOffsets(refs map[int32]*pkt.Refer) []int64 {
+	offsets := []int64{}
+	var off int64
+	for id := int32(0); refs[id] != nil; id++ {
+		offsets = append(offsets, off)
+		off = off + refs[id].OriginalSize
+	}
+	return offsets
+}
+
+// seek positions the reader on the block containing readpos, so blocks before
+// the range are never walked.
+func (me *DownLoadReader) seek(offsets []int64) {
+	idx := sort.Search(len(offsets), func(i int) bool { return offsets[i] > me.readpos }) - 1
+	if idx > 0 {
+		me.referIndex = int32(idx)
+		me.pos = offsets[idx]
+	}
+}
+
 func (me *DownLoadReader) readBlock() error {
 	if me.bin != nil {
 		_, ok := me.bin.(*codec.BlockReader)
@@ -58,19 +113,9 @@ func (me *DownLoadReader) readBlock() error {
 			return nil
 		}
 		if me.readpos < me.pos+refer.OriginalSize {
-			p := me.Progress.Path
-			if p != "" {
-				p = p + "block" + strconv.Itoa(int(me.referIndex))
-				os.Mkdir(p, os.ModePerm)
-				p = p + "/"
-			}
-			dn := &DownloadBlock{UClient: me.UClient, Ref: refer, Path: p}
-			if me.KSS != nil {
-				if int(me.referIndex) < len(me.KSS) {
-					dn.KS = me.KSS[me.referIndex]
-				}
-			}
-			plainblock, err := dn.Load()
+			p := me.blockPath(me.referIndex)
+			plainblock, err := me.loadBlock(me.referIndex, p)
+			me.prefetch()
 			if err != nil {
 				return me.ReadCaller(pkt.ToError(err))
 			}
@@ -92,10 +137,80 @@ func (me *DownLoadReader) readBlock() error {
 	return nil
 }
 
+func (me *DownLoadReader) blockPath(id int32) string {
+	p := me.Progress.Path
+	if p != "" {
+		p = p + "block" + strconv.Itoa(int(id))
+		os.Mkdir(p, os.ModePerm)
+		p = p + "/"
+	}
+	return p
+}
+
+func (me *DownLoadReader) downloadBlock(id int32, p string) *DownloadBlock {
+	dn := &DownloadBlock{UClient: me.UClient, Ref: me.Refs[id], Path: p}
+	if me.KSS != nil {
+		if int(id) < len(me.KSS) {
+			dn.KS = me.KSS[id]
+		}
+	}
+	return dn
+}
+
+// loadBlock returns block id, taking it from the read-ahead window if it has
+// been fetched already.
+func (me *DownLoadReader) loadBlock(id int32, p string) (*codec.PlainBlock, *pkt.ErrorMessage) {
+	if pb, ok := me.ahead[id]; ok {
+		delete(me.ahead, id)
+		pb.release()
+		return pb.block, pb.err
+	}
+	return me.downloadBlock(id, p).Load()
+}
+
+// prefetch starts downloading the blocks following the current one, up to
+// downloadPrefetch blocks and only while tokens are available.
+func (me *DownLoadReader) prefetch() {
+	if me.ahead == nil {
+		return
+	}
+	id := me.referIndex + 1
+	if id < me.nextFetch {
+		id = me.nextFetch
+	}
+	for ; id <= me.referIndex+int32(env.DownloadPrefetch); id++ {
+		if me.Refs[id] == nil || int(id) >= len(me.offsets) || me.offsets[id] >= me.end {
+			break
+		}
+		select {
+		case <-PREFETCH_CH:
+		default:
+			me.nextFetch = id
+			return
+		}
+		pb := &prefetchBlock{done: make(chan struct{})}
+		dn := me.downloadBlock(id, me.blockPath(id))
+		go func() {
+			pb.block, pb.err = dn.Load()
+			close(pb.done)
+		}()
+		me.ahead[id] = pb
+	}
+	me.nextFetch = id
+}
+
+func (me *DownLoadReader) dropPrefetch() {
+	for _, pb := range me.ahead {
+		go pb.release()
+	}
+	me.ahead = nil
+}
+
 func (me *DownLoadReader) ReadCaller(err error) error {
 	if me.BkCall == nil {
 		return err
 	}
+	me.dropPrefetch()
 	startpos := me.readpos / 16
 	skipn := me.readpos % 16
 	aes := NewAESDecodeReader(me.BkCall, startpos*16)
@@ -159,6 +274,7 @@ func (me *DownLoadReader) Read(p []byte) (n int, err error) {
 
 func (me *DownLoadReader) Close() error {
 	me.Refs = nil
+	me.dropPrefetch()
 	if me.bin != nil {
 		aes, ok := me.bin.(*AESDecodeReader)
 		if ok {
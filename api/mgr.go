@@ -336,6 +336,7 @@ func StartApi() {
 	InitBlockRoutinePool()
 	InitShardUpPool()
 	InitShardDownPool()
+	InitPrefetchPool()
 	net.InitClient()
 	err := cache.InitDB()
 	if err != nil {
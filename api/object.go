@@ -34,34 +34,12 @@ type FileItem struct {
 	Acl       []byte
 }
 
-const LengthKey = "contentLength"
-const ETagKey = "ETag"
-const DateKey = "x-amz-date"
+const LengthKey = pkt.FileMetaLengthKey
+const ETagKey = pkt.FileMetaETagKey
+const DateKey = pkt.FileMetaDateKey
 
 func BytesToFileMetaMap(meta []byte, versionid primitive.ObjectID) (map[string]string, error) {
-	if meta == nil {
-		return nil, errors.New("no data")
-	}
-	if len(meta) >= 24 {
-		m, err := pkt.UnmarshalMap(meta)
-		if err == nil {
-			s := m[ETagKey]
-			if s != "" {
-				return m, nil
-			}
-		}
-		id := env.BytesToId(meta)
-		hash := meta[8:]
-		m = make(map[string]string)
-		m[LengthKey] = strconv.Itoa(int(id))
-		m[ETagKey] = hex.EncodeToString(hash)
-		if versionid != primitive.NilObjectID {
-			m[DateKey] = versionid.Timestamp().String()
-		}
-		return m, nil
-	} else {
-		return nil, errors.New("err data")
-	}
+	return pkt.FileMetaToMap(meta, versionid)
 }
 
 func MetaTobytes(length int64, md5 []byte) []byte {
@@ -84,10 +62,35 @@ func FileMetaMapTobytes(m map[string]string) ([]byte, error) {
 	if err != nil {
 		return nil, errors.New(LengthKey + " value err")
 	}
+	for k := range m {
+		if k != ETagKey && k != LengthKey {
+			return pkt.MarshalMap(m)
+		}
+	}
 	bs1 := env.IdToBytes(int64(size))
 	return bytes.Join([][]byte{bs1, bs2}, []byte{}), nil
 }
 
+// HeaderMetaTobytes stores header alongside the length and md5; without
+// extra headers it keeps the compact MetaTobytes encoding.
+func HeaderMetaTobytes(length int64, md5 []byte, header map[string]string) []byte {
+	if len(header) == 0 {
+		return MetaTobytes(length, md5)
+	}
+	m := make(map[string]string, len(header)+2)
+	for k, v := range header {
+		m[k] = v
+	}
+	m[ETagKey] = hex.EncodeToString(md5)
+	m[LengthKey] = strconv.FormatInt(length, 10)
+	bs, err := pkt.MarshalMap(m)
+	if err != nil {
+		logrus.Warnf("[ObjectMeta]Marshal header ERR:%s\n", err)
+		return MetaTobytes(length, md5)
+	}
+	return bs
+}
+
 func (accessor *ObjectAccessor) CreateObject(bucketname, filename string, VNU primitive.ObjectID, meta []byte) *pkt.ErrorMessage {
 	req := &pkt.UploadFileReqV2{
 		UserId:     &accessor.UClient.UserId,
@@ -252,6 +255,14 @@ func (accessor *ObjectAccessor) GetListRespV2(resp *pkt.ListObjectRespV2) ([]*Fi
 	if resp.Data == nil || len(resp.Data) == 0 {
 		return []*FileItem{}, nil
 	}
+	msg, errmsg := decodeListRespV2(resp)
+	if errmsg != nil {
+		return nil, errmsg
+	}
+	return accessor.GetListRespV1(msg)
+}
+
+func decodeListRespV2(resp *pkt.ListObjectRespV2) (*pkt.ListObjectResp, *pkt.ErrorMessage) {
 	msg := &pkt.ListObjectResp{}
 	data := resp.Data
 	rd, err := gzip.NewReader(bytes.NewReader(data))
@@ -265,10 +276,60 @@ func (accessor *ObjectAccessor) GetListRespV2(resp *pkt.ListObjectRespV2) ([]*Fi
 	if err != nil {
 		return nil, pkt.NewErrorMsg(pkt.SERVER_ERROR, "Return ListObjectRespV2 ERR")
 	}
-	return accessor.GetListRespV1(msg)
+	return msg, nil
+}
+
+// ListObjectByName lists the latest versions in name order after marker.
+// With a delimiter, keys sharing the part up to it are returned as common
+// prefixes instead.
+func (accessor *ObjectAccessor) ListObjectByName(buck, marker, prefix, delimiter string, limit uint32) ([]*FileItem, []string, bool, *pkt.ErrorMessage) {
+	req := &pkt.ListObjectReqV2{
+		UserId:     &accessor.UClient.UserId,
+		SignData:   &accessor.UClient.SignKey.Sign,
+		KeyNumber:  &accessor.UClient.SignKey.KeyNumber,
+		BucketName: &buck,
+		FileName:   &marker,
+		Prefix:     &prefix,
+		Limit:      &limit,
+		Delimiter:  &delimiter,
+	}
+	resp, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[ListObject][%d]%s/%s/%s/%s ERR:%s\n", accessor.UClient.UserId, buck, marker, prefix, delimiter, pkt.ToError(errmsg))
+		return nil, nil, false, errmsg
+	}
+	msg, ok := resp.(*pkt.ListObjectResp)
+	if v2, ok2 := resp.(*pkt.ListObjectRespV2); ok2 {
+		msg, ok = &pkt.ListObjectResp{}, true
+		if len(v2.Data) > 0 {
+			if msg, errmsg = decodeListRespV2(v2); errmsg != nil {
+				return nil, nil, false, errmsg
+			}
+		}
+	}
+	if !ok {
+		logrus.Errorf("[ListObject][%d]%s/%s/%s/%s return err msg type.\n", accessor.UClient.UserId, buck, marker, prefix, delimiter)
+		return nil, nil, false, pkt.NewErrorMsg(pkt.SERVER_ERROR, "Return err msg type")
+	}
+	items, errmsg := accessor.GetListRespV1(msg)
+	if errmsg != nil {
+		return nil, nil, false, errmsg
+	}
+	logrus.Infof("[ListObject][%d]%s/%s/%s/%s OK,count:%d,prefixes:%d\n", accessor.UClient.UserId, buck, marker, prefix, delimiter, len(items), len(msg.CommonPrefixes))
+	truncated := msg.GetTruncated()
+	if msg.Truncated == nil {
+		truncated = len(items) >= int(limit)
+	}
+	return items, msg.CommonPrefixes, truncated, nil
 }
 
 func (accessor *ObjectAccessor) DeleteObject(buck, fileName string, Verid primitive.ObjectID) *pkt.ErrorMessage {
+	return accessor.DeleteObjectBypass(buck, fileName, Verid, false)
+}
+
+// DeleteObjectBypass deletes like DeleteObject, removing versions under
+// governance retention too when bypass is set.
+func (accessor *ObjectAccessor) DeleteObjectBypass(buck, fileName string, Verid primitive.ObjectID, bypass bool) *pkt.ErrorMessage {
 	req := &pkt.DeleteFileReqV2{
 		UserId:     &accessor.UClient.UserId,
 		SignData:   &accessor.UClient.SignKey.Sign,
@@ -276,6 +337,9 @@ func (accessor *ObjectAccessor) DeleteObject(buck, fileName string, Verid primit
 		BucketName: &buck,
 		FileName:   &fileName,
 	}
+	if bypass {
+		req.BypassGovernance = &bypass
+	}
 	if Verid != primitive.NilObjectID {
 		i1, i2, i3, i4 := pkt.ObjectIdParam(Verid)
 		v := &pkt.DeleteFileReqV2_VNU{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/net"
+	"github.com/yottachain/YTCoreService/pkt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (accessor *ObjectAccessor) GetObjectLock(buck, fileName string, Verid primitive.ObjectID) (*pkt.ObjectLock, *pkt.ErrorMessage) {
+	req := &pkt.GetObjectLockReqV2{
+		UserId:     &accessor.UClient.UserId,
+		SignData:   &accessor.UClient.SignKey.Sign,
+		KeyNumber:  &accessor.UClient.SignKey.KeyNumber,
+		BucketName: &buck,
+		FileName:   &fileName,
+	}
+	if Verid != primitive.NilObjectID {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(Verid)
+		req.Vnu = &pkt.GetObjectLockReqV2_VNU{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+	}
+	resp, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[GetObjectLock][%d]%s/%s ERR:%s\n", accessor.UClient.UserId, buck, fileName, pkt.ToError(errmsg))
+		return nil, errmsg
+	}
+	dresp, OK := resp.(*pkt.GetObjectLockResp)
+	if !OK {
+		logrus.Errorf("[GetObjectLock][%d]%s/%s RETURN_ERR_MSG\n", accessor.UClient.UserId, buck, fileName)
+		return nil, pkt.NewErrorMsg(pkt.SERVER_ERROR, "Return err msg type")
+	}
+	return &pkt.ObjectLock{Mode: dresp.GetMode(), RetainUntil: dresp.GetRetainUntil(), LegalHold: dresp.GetLegalHold()}, nil
+}
+
+// PutObjectRetention sets the retention of a version, removing it when mode
+// is empty. retainUntil is in unix seconds.
+func (accessor *ObjectAccessor) PutObjectRetention(buck, fileName string, Verid primitive.ObjectID, mode string, retainUntil int64, bypass bool) *pkt.ErrorMessage {
+	req := &pkt.PutObjectRetentionReqV2{
+		UserId:           &accessor.UClient.UserId,
+		SignData:         &accessor.UClient.SignKey.Sign,
+		KeyNumber:        &accessor.UClient.SignKey.KeyNumber,
+		BucketName:       &buck,
+		FileName:         &fileName,
+		Mode:             &mode,
+		RetainUntil:      &retainUntil,
+		BypassGovernance: &bypass,
+	}
+	if Verid != primitive.NilObjectID {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(Verid)
+		req.Vnu = &pkt.PutObjectRetentionReqV2_VNU{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+	}
+	_, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[PutObjectRetention][%d]%s/%s ERR:%s\n", accessor.UClient.UserId, buck, fileName, pkt.ToError(errmsg))
+		return errmsg
+	}
+	logrus.Infof("[PutObjectRetention][%d]%s/%s OK,mode:%s\n", accessor.UClient.UserId, buck, fileName, mode)
+	return nil
+}
+
+func (accessor *ObjectAccessor) PutObjectLegalHold(buck, fileName string, Verid primitive.ObjectID, hold bool) *pkt.ErrorMessage {
+	req := &pkt.PutObjectLegalHoldReqV2{
+		UserId:     &accessor.UClient.UserId,
+		SignData:   &accessor.UClient.SignKey.Sign,
+		KeyNumber:  &accessor.UClient.SignKey.KeyNumber,
+		BucketName: &buck,
+		FileName:   &fileName,
+		LegalHold:  &hold,
+	}
+	if Verid != primitive.NilObjectID {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(Verid)
+		req.Vnu = &pkt.PutObjectLegalHoldReqV2_VNU{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+	}
+	_, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[PutObjectLegalHold][%d]%s/%s ERR:%s\n", accessor.UClient.UserId, buck, fileName, pkt.ToError(errmsg))
+		return errmsg
+	}
+	logrus.Infof("[PutObjectLegalHold][%d]%s/%s OK,hold:%t\n", accessor.UClient.UserId, buck, fileName, hold)
+	return nil
+}
@@ -0,0 +1,41 @@
+package api
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yottachain/YTCoreService/s3"
+)
+
+func (c *Client) PresignURL(method, endpoint, bucketname, key string, query url.Values, expires time.Duration) (string, error) {
+	if c.SignKey == nil || c.SignKey.PrivateKey == "" {
+		return "", errors.New("sign key not available")
+	}
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil {
+		return "", err
+	}
+	u.Path = u.Path + "/" + bucketname + "/" + key
+	if query != nil {
+		u.RawQuery = query.Encode()
+	}
+	return s3.PresignV4(method, u.String(), "YTA"+c.SignKey.PublicKey, c.SignKey.PrivateKey, "", time.Now(), expires)
+}
+
+func (c *Client) PresignGetURL(endpoint, bucketname, key string, expires time.Duration) (string, error) {
+	return c.PresignURL("GET", endpoint, bucketname, key, nil, expires)
+}
+
+func (c *Client) PresignPutURL(endpoint, bucketname, key string, expires time.Duration) (string, error) {
+	return c.PresignURL("PUT", endpoint, bucketname, key, nil, expires)
+}
+
+func (c *Client) PresignUploadPartURL(endpoint, bucketname, key, uploadId string, partNumber int, expires time.Duration) (string, error) {
+	query := url.Values{}
+	query.Set("uploadId", uploadId)
+	query.Set("partNumber", strconv.Itoa(partNumber))
+	return c.PresignURL("PUT", endpoint, bucketname, key, query, expires)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/yottachain/YTCoreService/api"
 	"github.com/yottachain/YTCoreService/env"
+	"github.com/yottachain/YTCoreService/s3"
 )
 
 //User 用户注册
@@ -64,9 +65,10 @@ func Register(g *gin.Context) {
 		g.JSON(http.StatusUnauthorized, gin.H{"status": http.StatusUnauthorized, "Msg": "privateKey is empty"})
 		return
 	}
+	var client *api.Client
 	var err2 error
 	for {
-		_, err2 = api.NewClient(&api.UserInfo{
+		client, err2 = api.NewClient(&api.UserInfo{
 			UserName: userName,
 			Privkey:  []string{privateKey}}, 3)
 		if err2 != nil {
@@ -92,7 +94,8 @@ func Register(g *gin.Context) {
 				return
 			}*/
 		logrus.Infof("[Register]User Register Success,UserName: %s\n", userName)
-		g.JSON(http.StatusOK, gin.H{"status": http.StatusOK, "Msg": "Register success " + userName})
+		g.JSON(http.StatusOK, gin.H{"status": http.StatusOK, "Msg": "Register success " + userName,
+			"accessKey": "YTA" + client.SignKey.PublicKey, "secretKey": s3.DeriveSecretKey(client.SignKey.PrivateKey)})
 	}
 }
 
@@ -1,56 +1,19 @@
 package sgx
 
 import (
-	"bytes"
-	"compress/zlib"
-	"errors"
-	"io"
+	"github.com/yottachain/YTCoreService/codec"
 )
 
+// BlockReader unpacks a decrypted block through codec's BlockReader, which
+// dispatches on the codec recorded in the block header.
 type BlockReader struct {
-	block  []byte
-	head   int
-	reader io.Reader
+	*codec.BlockReader
 }
 
 func NewBlockReader(data []byte) (*BlockReader, error) {
-	var ret int16 = 0
-	ret <<= 8
-	ret |= int16(data[0] & 0xFF)
-	ret <<= 8
-	ret |= int16(data[1] & 0xFF)
-	r := new(BlockReader)
-	r.block = data
-	r.head = int(ret)
-	var err error = nil
-	if r.head == 0 {
-		r.reader, err = zlib.NewReader(bytes.NewReader(data[2:]))
-	} else if r.head < 0 {
-		r.reader = bytes.NewReader(data[2:])
-	} else {
-		end := len(data) - r.head
-		if end < 2 {
-			err = errors.New("Decode err")
-		} else {
-			r.reader, err = zlib.NewReader(bytes.NewReader(data[2:end]))
-		}
+	r, err := codec.NewBlockReader(codec.NewPlainBlock(data, 0))
+	if err != nil {
+		return nil, err
 	}
-	return r, err
-}
-
-func (br *BlockReader) Read(p []byte) (n int, err error) {
-	var num int = 0
-	num, err = br.reader.Read(p)
-	if err == io.EOF {
-		if num > 0 {
-			return num, nil
-		}
-		if br.head > 0 {
-			size := len(br.block)
-			br.reader = bytes.NewReader(br.block[size-br.head : size])
-			br.head = 0
-			return br.Read(p)
-		}
-	}
-	return num, err
+	return &BlockReader{r}, nil
 }
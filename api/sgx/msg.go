@@ -2,30 +2,23 @@ package sgx
 
 import (
 	"bytes"
-	"crypto/aes"
-	"crypto/cipher"
-	"crypto/md5"
 	"encoding/binary"
 	"errors"
 	"io"
-	"math/rand"
-	"time"
-)
 
-var IVParameter []byte
+	"github.com/yottachain/YTCoreService/codec"
+)
 
-func init() {
-	bs := []byte("YottaChain2018王东临侯月文韩大光")
-	md5Digest := md5.New()
-	md5Digest.Write(bs)
-	IVParameter = md5Digest.Sum(nil)
-	rand.Seed(time.Now().UnixNano())
-}
+var IVParameter = codec.IVParameter
 
+// EncryptedBlock is a block handed to an SGX or mobile reader. Format is
+// the block format from its refer; it follows the data on the wire and is
+// left out for blocks recorded without one, which are CBC.
 type EncryptedBlock struct {
 	DATA      []byte
 	KEU       []byte
 	KeyNumber int32
+	Format    uint8
 }
 
 func NewEncryptedBlock(bs []byte) *EncryptedBlock {
@@ -40,6 +33,9 @@ func NewEncryptedBlock(bs []byte) *EncryptedBlock {
 	headbuf.Read(eb.KEU)
 	eb.DATA = make([]byte, datasize)
 	headbuf.Read(eb.DATA)
+	if format, err := headbuf.ReadByte(); err == nil {
+		eb.Format = format
+	}
 	return eb
 }
 
@@ -52,6 +48,9 @@ func (b *EncryptedBlock) ToBytes() []byte {
 	binary.Write(bytebuf, binary.BigEndian, datasize)
 	bytebuf.Write(b.KEU)
 	bytebuf.Write(b.DATA)
+	if b.Format != 0 {
+		bytebuf.WriteByte(b.Format)
+	}
 	return bytebuf.Bytes()
 }
 
@@ -62,19 +61,13 @@ func (b *EncryptedBlock) Decrypt(key *Key) ([]byte, error) {
 	if b.KeyNumber != int32(key.KeyNumber) {
 		return nil, errors.New("KeyNumber err")
 	}
-	bs := key.Decrypt(b.KEU)
-	length := len(b.DATA)
-	if length%16 > 0 {
-		return nil, errors.New("data err")
-	}
-	block, err := aes.NewCipher(bs)
+	eb := &codec.EncryptedBlock{SecretKey: key.Decrypt(b.KEU), Format: b.Format}
+	eb.Data = b.DATA
+	pb, err := codec.NewBlockAESDecryptor(eb).Decrypt()
 	if err != nil {
 		return nil, err
 	}
-	blockMode := cipher.NewCBCDecrypter(block, IVParameter)
-	dstData := make([]byte, length)
-	blockMode.CryptBlocks(dstData, b.DATA)
-	return PKCS7UnPadding(dstData), nil
+	return pb.Data, nil
 }
 
 func (b *EncryptedBlock) Decode(key *Key, writer io.Writer) error {
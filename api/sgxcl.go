@@ -96,5 +96,6 @@ func (down *DownloadForSGX) LoadEncryptedBlock(id int32) (*sgx.EncryptedBlock, *
 	sgxb.DATA = eb.Data
 	sgxb.KEU = refer.KEU
 	sgxb.KeyNumber = int32(refer.KeyNumber)
+	sgxb.Format = refer.Format
 	return sgxb, nil
 }
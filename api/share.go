@@ -112,6 +112,7 @@ func (imp *AuthImporter) checkHash() *pkt.ErrorMessage {
 	do.Length = imp.Length
 	do.RSS = imp.KSS
 	reader := do.Load()
+	defer reader.Close()
 	sha256Digest := sha256.New()
 	size, err := codec.CalHash(sha256Digest, reader)
 	if err != nil {
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/net"
+	"github.com/yottachain/YTCoreService/pkt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (accessor *ObjectAccessor) PutObjectTagging(buck, fileName string, Verid primitive.ObjectID, tags map[string]string) *pkt.ErrorMessage {
+	bs, err := pkt.MarshalMap(tags)
+	if err != nil {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, err.Error())
+	}
+	req := &pkt.PutObjectTaggingReqV2{
+		UserId:     &accessor.UClient.UserId,
+		SignData:   &accessor.UClient.SignKey.Sign,
+		KeyNumber:  &accessor.UClient.SignKey.KeyNumber,
+		BucketName: &buck,
+		FileName:   &fileName,
+		Tags:       bs,
+	}
+	if Verid != primitive.NilObjectID {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(Verid)
+		req.Vnu = &pkt.PutObjectTaggingReqV2_VNU{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+	}
+	_, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[PutObjectTagging][%d]%s/%s ERR:%s\n", accessor.UClient.UserId, buck, fileName, pkt.ToError(errmsg))
+		return errmsg
+	}
+	logrus.Infof("[PutObjectTagging][%d]%s/%s OK,tags:%d\n", accessor.UClient.UserId, buck, fileName, len(tags))
+	return nil
+}
+
+func (accessor *ObjectAccessor) GetObjectTagging(buck, fileName string, Verid primitive.ObjectID) (map[string]string, *pkt.ErrorMessage) {
+	req := &pkt.GetObjectTaggingReqV2{
+		UserId:     &accessor.UClient.UserId,
+		SignData:   &accessor.UClient.SignKey.Sign,
+		KeyNumber:  &accessor.UClient.SignKey.KeyNumber,
+		BucketName: &buck,
+		FileName:   &fileName,
+	}
+	if Verid != primitive.NilObjectID {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(Verid)
+		req.Vnu = &pkt.GetObjectTaggingReqV2_VNU{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+	}
+	resp, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[GetObjectTagging][%d]%s/%s ERR:%s\n", accessor.UClient.UserId, buck, fileName, pkt.ToError(errmsg))
+		return nil, errmsg
+	}
+	dresp, OK := resp.(*pkt.GetObjectTaggingResp)
+	if !OK {
+		logrus.Errorf("[GetObjectTagging][%d]%s/%s RETURN_ERR_MSG\n", accessor.UClient.UserId, buck, fileName)
+		return nil, pkt.NewErrorMsg(pkt.SERVER_ERROR, "Return err msg type")
+	}
+	if len(dresp.Tags) == 0 {
+		return map[string]string{}, nil
+	}
+	tags, err := pkt.UnmarshalMap(dresp.Tags)
+	if err != nil {
+		return nil, pkt.NewErrorMsg(pkt.SERVER_ERROR, "Return tags ERR")
+	}
+	return tags, nil
+}
+
+// ListObjectByTag lists the objects whose latest version carries the tag;
+// pass the FileId of the last item returned to get the next page.
+func (accessor *ObjectAccessor) ListObjectByTag(buck, tagKey, tagValue string, startId primitive.ObjectID, limit uint32) ([]*FileItem, *pkt.ErrorMessage) {
+	req := &pkt.ListObjectByTagReqV2{
+		UserId:     &accessor.UClient.UserId,
+		SignData:   &accessor.UClient.SignKey.Sign,
+		KeyNumber:  &accessor.UClient.SignKey.KeyNumber,
+		BucketName: &buck,
+		TagKey:     &tagKey,
+		TagValue:   &tagValue,
+		Limit:      &limit,
+	}
+	if startId != primitive.NilObjectID {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(startId)
+		req.Startid = &pkt.ListObjectByTagReqV2_StartId{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+	}
+	resp, errmsg := net.RequestSN(req)
+	if errmsg != nil {
+		logrus.Errorf("[ListObjectByTag][%d]%s/%s=%s ERR:%s\n", accessor.UClient.UserId, buck, tagKey, tagValue, pkt.ToError(errmsg))
+		return nil, errmsg
+	}
+	dresp, OK := resp.(*pkt.ListObjectResp)
+	if !OK {
+		logrus.Errorf("[ListObjectByTag][%d]%s/%s=%s RETURN_ERR_MSG\n", accessor.UClient.UserId, buck, tagKey, tagValue)
+		return nil, pkt.NewErrorMsg(pkt.SERVER_ERROR, "Return err msg type")
+	}
+	return accessor.GetListRespV1(dresp)
+}
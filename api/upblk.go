@@ -95,7 +95,6 @@ func (uploadBlock *UploadBlock) upload() {
 	dupResp, ok := resp.(*pkt.UploadBlockDupResp)
 	if ok {
 		osize := uint64(uploadBlock.BLK.OriginalSize)
-		rsize := uint32(len(uploadBlock.BLK.Data))
 		dupReq := uploadBlock.CheckBlockDup(dupResp)
 		v := &pkt.UploadBlockDupReqV2_VNU{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
 		if dupReq != nil {
@@ -103,7 +102,6 @@ func (uploadBlock *UploadBlock) upload() {
 			dupReq.Id = &bid
 			dupReq.VHP = uploadBlock.BLK.VHP
 			dupReq.OriginalSize = &osize
-			dupReq.RealSize = &rsize
 			dupReq.Vnu = v
 			_, errmsg = net.RequestSN(dupReq)
 			if errmsg != nil {
@@ -154,8 +152,8 @@ func (uploadBlock *UploadBlock) UploadBlockDB() {
 			Vnu:          vnu,
 			VHP:          uploadBlock.BLK.VHP,
 			VHB:          eblk.VHB,
-			KEU:          codec.ECBEncryptNoPad(ks, uploadBlock.UPOBJ.UClient.StoreKey.AESKey),
-			KED:          codec.ECBEncryptNoPad(ks, uploadBlock.BLK.KD),
+			KEU:          uploadBlock.UPOBJ.encryptKey(ks),
+			KED:          eblk.MakeKED(uploadBlock.BLK.KD),
 			OriginalSize: &osize,
 			Data:         eblk.Data,
 		}
@@ -180,8 +178,10 @@ func (uploadBlock *UploadBlock) CheckBlockDup(resp *pkt.UploadBlockDupResp) *pkt
 	vhbs := resp.Vhbs.VHB
 	ars := resp.Ars.AR
 	for index, ked := range keds {
-		ks := codec.ECBDecryptNoPad(ked, uploadBlock.BLK.KD)
-		aes := codec.NewBlockAESEncryptor(uploadBlock.BLK, ks)
+		aes := codec.NewDupAESEncryptor(uploadBlock.BLK, ked)
+		if aes == nil {
+			continue
+		}
 		eblk, err := aes.Encrypt()
 		if err != nil {
 			logrus.Warnf("[UploadBlock]%sCheckBlockDup ERR:%s\n", uploadBlock.logPrefix, err)
@@ -210,13 +210,15 @@ func (uploadBlock *UploadBlock) CheckBlockDup(resp *pkt.UploadBlockDupResp) *pkt
 			vhb = eblk.VHB
 		}
 		if bytes.Equal(vhb, vhbs[index]) {
-			keu := codec.ECBEncryptNoPad(ks, uploadBlock.UPOBJ.UClient.StoreKey.AESKey)
+			keu := uploadBlock.UPOBJ.encryptKey(eblk.SecretKey)
+			rsize := uint32(eblk.RealSize)
 			req := &pkt.UploadBlockDupReqV2{
 				UserId:    &uploadBlock.UPOBJ.UClient.UserId,
 				SignData:  &uploadBlock.UPOBJ.UClient.SignKey.Sign,
 				KeyNumber: &uploadBlock.UPOBJ.UClient.SignKey.KeyNumber,
 				VHB:       vhb,
 				KEU:       keu,
+				RealSize:  &rsize,
 			}
 			if uploadBlock.UPOBJ.UClient.StoreKey != uploadBlock.UPOBJ.UClient.SignKey {
 				sign, _ := SetStoreNumber(uploadBlock.UPOBJ.UClient.SignKey.Sign, int32(uploadBlock.UPOBJ.UClient.StoreKey.KeyNumber))
@@ -230,13 +232,13 @@ func (uploadBlock *UploadBlock) CheckBlockDup(resp *pkt.UploadBlockDupResp) *pkt
 
 func (uploadBlock *UploadBlock) UploadBlockDedup() {
 	ks := codec.GenerateRandomKey()
-	rsize := int32(len(uploadBlock.BLK.Data))
 	aes := codec.NewBlockAESEncryptor(uploadBlock.BLK, ks)
 	eblk, err := aes.Encrypt()
 	if err != nil {
 		uploadBlock.UPOBJ.ERR.Store(pkt.NewErrorMsg(pkt.INVALID_ARGS, err.Error()))
 		return
 	}
+	rsize := int32(eblk.RealSize)
 	enc := codec.NewErasureEncoder(eblk)
 	err = enc.Encode()
 	if err != nil {
@@ -250,8 +252,8 @@ func (uploadBlock *UploadBlock) UploadBlockDedup() {
 	retrytimes := 0
 	size := len(enc.Shards)
 	ress := make([]*UploadShardResult, size)
-	keu := codec.ECBEncryptNoPad(ks, uploadBlock.UPOBJ.UClient.StoreKey.AESKey)
-	ked := codec.ECBEncryptNoPad(ks, uploadBlock.BLK.KD)
+	keu := uploadBlock.UPOBJ.encryptKey(ks)
+	ked := eblk.MakeKED(uploadBlock.BLK.KD)
 	useex := false
 	var ress2 []*UploadShardResult = nil
 	if !enc.IsCopyShard() && size > env.LRCMinShardNum {
@@ -45,7 +45,7 @@ func checkCacheSize() bool {
 	return true
 }
 
-func UploadMultiPartFile(userid int32, path []string, bucketname, key string) ([]byte, *pkt.ErrorMessage) {
+func UploadMultiPartFile(userid int32, path []string, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
 	if !checkCacheSize() {
 		return nil, pkt.NewErrorMsg(pkt.CACHE_FULL, "Cache space overflow")
 	}
@@ -57,6 +57,9 @@ func UploadMultiPartFile(userid int32, path []string, bucketname, key string) ([
 	defer enc.Close()
 	k := &cache.Key{UserID: userid, Bucket: bucketname, ObjectName: key}
 	v := cache.MultiPartFileValue(path, enc.GetLength(), enc.GetMD5())
+	if len(header) > 0 {
+		v.Meta = HeaderMetaTobytes(enc.GetLength(), enc.GetMD5(), header)
+	}
 	err = cache.InsertValue(k, v)
 	if err != nil {
 		logrus.Errorf("[UploadMultiPartFile]%s/%s,Insert cache ERR:%s\n", bucketname, key, err)
@@ -67,7 +70,7 @@ func UploadMultiPartFile(userid int32, path []string, bucketname, key string) ([
 	return enc.GetMD5(), nil
 }
 
-func UploadSingleFile(userid int32, path string, bucketname, key string) ([]byte, *pkt.ErrorMessage) {
+func UploadSingleFile(userid int32, path string, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
 	if !checkCacheSize() {
 		return nil, pkt.NewErrorMsg(pkt.CACHE_FULL, "Cache space overflow")
 	}
@@ -79,6 +82,9 @@ func UploadSingleFile(userid int32, path string, bucketname, key string) ([]byte
 	defer enc.Close()
 	k := &cache.Key{UserID: userid, Bucket: bucketname, ObjectName: key}
 	v := cache.SingleFileValue(path, enc.GetLength(), enc.GetMD5())
+	if len(header) > 0 {
+		v.Meta = HeaderMetaTobytes(enc.GetLength(), enc.GetMD5(), header)
+	}
 	err = cache.InsertValue(k, v)
 	if err != nil {
 		logrus.Errorf("[UploadSingleFile]%s/%s,Insert cache ERR:%s\n", bucketname, key, err)
@@ -89,7 +95,7 @@ func UploadSingleFile(userid int32, path string, bucketname, key string) ([]byte
 	return enc.GetMD5(), nil
 }
 
-func UploadBytesFile(userid int32, data []byte, bucketname, key string) ([]byte, *pkt.ErrorMessage) {
+func UploadBytesFile(userid int32, data []byte, bucketname, key string, header map[string]string) ([]byte, *pkt.ErrorMessage) {
 	if !checkCacheSize() {
 		return nil, pkt.NewErrorMsg(pkt.CACHE_FULL, "Cache space overflow")
 	}
@@ -101,6 +107,9 @@ func UploadBytesFile(userid int32, data []byte, bucketname, key string) ([]byte,
 	defer enc.Close()
 	k := &cache.Key{UserID: userid, Bucket: bucketname, ObjectName: key}
 	v := cache.BytesFileValue(data, enc.GetLength(), enc.GetMD5())
+	if len(header) > 0 {
+		v.Meta = HeaderMetaTobytes(enc.GetLength(), enc.GetMD5(), header)
+	}
 	err = cache.InsertValue(k, v)
 	if err != nil {
 		logrus.Errorf("[UploadBytesFile]%s/%s,Insert cache ERR:%s\n", bucketname, key, err)
@@ -198,12 +207,7 @@ func doUpload(ca *cache.Cache) *pkt.ErrorMessage {
 		logrus.Errorf("[AyncUpload]Client %d offline.\n", ca.K.UserID)
 		return pkt.NewErrorMsg(pkt.INVALID_USER_ID, "Client offline")
 	}
-	var obj UploadObjectBase
-	if env.Driver == "nas" {
-		obj = NewUploadObjectToDisk(c, ca.K.Bucket, ca.K.ObjectName)
-	} else {
-		obj = NewUploadObject(c)
-	}
+	obj := newBucketUploadObject(c, ca.K.Bucket, ca.K.ObjectName)
 	PutUploadObject(int32(c.UserId), ca.K.Bucket, ca.K.ObjectName, obj)
 	defer func() {
 		DelUploadObject(int32(c.UserId), ca.K.Bucket, ca.K.ObjectName)
@@ -227,7 +231,10 @@ func doUpload(ca *cache.Cache) *pkt.ErrorMessage {
 		}
 	}
 	if r, ok := obj.(*UploadObject); ok {
-		meta := MetaTobytes(obj.GetLength(), obj.GetMD5())
+		meta := ca.V.Meta
+		if len(meta) == 0 {
+			meta = MetaTobytes(obj.GetLength(), obj.GetMD5())
+		}
 		err := c.NewObjectAccessor().CreateObject(ca.K.Bucket, ca.K.ObjectName, r.VNU, meta)
 		if err != nil {
 			logrus.Errorf("[AyncUpload]WriteMeta ERR:%s,%s/%s\n", pkt.ToError(err), ca.K.Bucket, ca.K.ObjectName)
@@ -1,8 +1,12 @@
 package api
 
 import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"sync"
 	"sync/atomic"
 
@@ -25,6 +29,8 @@ type UploadObject struct {
 	Exist   bool
 	ERR     atomic.Value
 	PRO     *UpProgress
+	SSEKey  []byte
+	Bucket  string
 }
 
 func NewUploadObject(c *Client) *UploadObject {
@@ -33,6 +39,17 @@ func NewUploadObject(c *Client) *UploadObject {
 	return o
 }
 
+// newBucketUploadObject returns the uploader for an object written to bucket,
+// so the SN can turn away uploads exceeding the bucket quota early.
+func newBucketUploadObject(c *Client, bucketname, objectname string) UploadObjectBase {
+	if env.Driver == "nas" {
+		return NewUploadObjectToDisk(c, bucketname, objectname)
+	}
+	o := NewUploadObject(c)
+	o.Bucket = bucketname
+	return o
+}
+
 func (uploadobject *UploadObject) GetLength() int64 {
 	if uploadobject.Encoder != nil {
 		return uploadobject.Encoder.GetLength()
@@ -87,6 +104,57 @@ func (uploadobject *UploadObject) UploadBytes(data []byte) *pkt.ErrorMessage {
 	return uploadobject.Upload()
 }
 
+// UploadStream encodes and uploads blocks while r is being read. The object
+// is initialized under a provisional VHW and rekeyed by the SN on completion.
+// As the VHW is unknown at init, a streamed object is never deduplicated
+// with an identical stored object as a whole; its blocks still are.
+func (uploadobject *UploadObject) UploadStream(r io.Reader, size int64) *pkt.ErrorMessage {
+	enc, err := codec.NewStreamEncoder(r, size)
+	if err != nil {
+		logrus.Errorf("[NewStreamEncoder]ERR:%s\n", err)
+		return pkt.NewErrorMsg(pkt.CODEC_ERROR, err.Error())
+	}
+	uploadobject.Encoder = enc
+	defer enc.Close()
+	return uploadobject.Upload()
+}
+
+func provisionalVHW() []byte {
+	id := primitive.NewObjectID()
+	sha := sha256.Sum256(id[:])
+	return sha[:]
+}
+
+// objectVHW keeps objects under a customer key from being deduplicated with
+// copies stored under another key, since their KEUs are wrapped differently.
+func (uploadobject *UploadObject) objectVHW(vhw []byte) []byte {
+	if uploadobject.SSEKey == nil {
+		return vhw
+	}
+	mac := hmac.New(sha256.New, uploadobject.SSEKey)
+	mac.Write(vhw)
+	return mac.Sum(nil)
+}
+
+// ETag returns the MD5 of the object, or for an object under a customer
+// key a digest keyed by it, so the ETag is no fingerprint of the plaintext.
+func (uploadobject *UploadObject) ETag() []byte {
+	if uploadobject.SSEKey == nil {
+		return uploadobject.GetMD5()
+	}
+	mac := hmac.New(sha256.New, uploadobject.SSEKey)
+	mac.Write(uploadobject.GetMD5())
+	return mac.Sum(nil)[0:md5.Size]
+}
+
+func (uploadobject *UploadObject) encryptKey(ks []byte) []byte {
+	keu := codec.ECBEncryptNoPad(ks, uploadobject.UClient.StoreKey.AESKey)
+	if uploadobject.SSEKey != nil {
+		keu = codec.ECBEncryptNoPad(keu, uploadobject.SSEKey)
+	}
+	return keu
+}
+
 func (uploadobject *UploadObject) IdExist(id uint32) bool {
 	if uploadobject.Blocks == nil {
 		return false
@@ -106,7 +174,14 @@ func (uploadobject *UploadObject) GetProgress() int32 {
 var RunningMap sync.Map
 
 func (uploadobject *UploadObject) Upload() (reserr *pkt.ErrorMessage) {
+	vhw := uploadobject.objectVHW(uploadobject.Encoder.GetVHW())
 	key := hex.EncodeToString(uploadobject.GetMD5())
+	if uploadobject.Encoder.IsStream() {
+		vhw = provisionalVHW()
+		key = hex.EncodeToString(vhw)
+	} else if uploadobject.SSEKey != nil {
+		key = hex.EncodeToString(vhw)
+	}
 	if obj, has := RunningMap.Load(key); has {
 		up := obj.(*UploadObject)
 		logrus.Infof("[UploadObject][%s]Uploading...\n", up.VNU.Hex())
@@ -122,7 +197,7 @@ func (uploadobject *UploadObject) Upload() (reserr *pkt.ErrorMessage) {
 		}
 	}()
 	uploadobject.PRO.Length.Set(uploadobject.Encoder.GetLength())
-	err := uploadobject.initUpload(uploadobject.Encoder.GetVHW(), uploadobject.Encoder.GetLength())
+	err := uploadobject.initUpload(vhw, uploadobject.Encoder.GetLength())
 	if err != nil {
 		uploadobject.ERR.Store(err)
 		return err
@@ -164,7 +239,7 @@ func (uploadobject *UploadObject) Upload() (reserr *pkt.ErrorMessage) {
 		if v != nil {
 			errmsg = v.(*pkt.ErrorMessage)
 		} else {
-			errmsg = uploadobject.complete(uploadobject.Encoder.GetVHW())
+			errmsg = uploadobject.complete(vhw)
 		}
 		if errmsg != nil {
 			logrus.Errorf("[UploadObject][%s]Upload ERR:%s\n", uploadobject.VNU.Hex(), pkt.ToError(errmsg))
@@ -186,6 +261,9 @@ func (uploadobject *UploadObject) complete(sha []byte) *pkt.ErrorMessage {
 		VHW:       sha,
 		Vnu:       vnu,
 	}
+	if uploadobject.Encoder != nil && uploadobject.Encoder.IsStream() {
+		req.FinalVHW = uploadobject.objectVHW(uploadobject.Encoder.GetVHW())
+	}
 	_, errmsg := net.RequestSN(req)
 	if errmsg != nil && errmsg.Code != pkt.INVALID_UPLOAD_ID {
 		return errmsg
@@ -202,6 +280,13 @@ func (uploadobject *UploadObject) initUpload(sha []byte, length int64) *pkt.Erro
 		VHW:       sha,
 		Length:    &size,
 	}
+	if uploadobject.Bucket != "" {
+		req.BucketName = &uploadobject.Bucket
+	}
+	if uploadobject.SSEKey != nil {
+		sum := md5.Sum(uploadobject.SSEKey)
+		req.SseKeyMD5 = sum[:]
+	}
 	var initresp *pkt.UploadObjectInitResp
 	resp, errmsg := net.RequestSN(req)
 	if errmsg != nil {
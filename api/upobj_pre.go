@@ -123,11 +123,11 @@ func (ud *UploadObjectToDisk) Check(b *codec.PlainBlock, id int) (*codec.Encoded
 	}
 	dupResp, ok := resp.(*pkt.UploadBlockDupResp)
 	if ok {
-		keu, vhb := ud.CheckBlockDup(dupResp, b)
+		keu, vhb, rsize := ud.CheckBlockDup(dupResp, b)
 		if keu != nil {
 			logrus.Infof("[UploadObjectToDisk][%s/%s]Write Block %d:repeat\n", ud.Bucket, ud.ObjectKey, id)
 			return &codec.EncodedBlock{IsDup: true, OriginalSize: b.OriginalSize,
-				RealSize: b.Length(), VHP: b.VHP, KEU: keu, VHB: vhb}, nil
+				RealSize: rsize, VHP: b.VHP, KEU: keu, VHB: vhb}, nil
 		}
 	}
 	bb, err := ud.makeNODupBlock(b)
@@ -141,41 +141,43 @@ func (ud *UploadObjectToDisk) Check(b *codec.PlainBlock, id int) (*codec.Encoded
 
 func (ud *UploadObjectToDisk) makeNODupBlock(b *codec.PlainBlock) (*codec.EncodedBlock, *pkt.ErrorMessage) {
 	ks := codec.GenerateRandomKey()
-	rsize := b.Length()
 	aes := codec.NewBlockAESEncryptor(b, ks)
 	eblk, err := aes.Encrypt()
 	if err != nil {
 		return nil, pkt.NewErrorMsg(pkt.INVALID_ARGS, err.Error())
 	}
+	rsize := eblk.RealSize
 	keu := codec.ECBEncryptNoPad(ks, ud.UClient.StoreKey.AESKey)
-	ked := codec.ECBEncryptNoPad(ks, b.KD)
+	ked := eblk.MakeKED(b.KD)
 	return &codec.EncodedBlock{IsDup: false, OriginalSize: b.OriginalSize,
 		RealSize: rsize, VHP: b.VHP, KEU: keu, KED: ked, DATA: eblk.Data}, nil
 }
 
-func (ud *UploadObjectToDisk) CheckBlockDup(resp *pkt.UploadBlockDupResp, b *codec.PlainBlock) ([]byte, []byte) {
+func (ud *UploadObjectToDisk) CheckBlockDup(resp *pkt.UploadBlockDupResp, b *codec.PlainBlock) ([]byte, []byte, int64) {
 	keds := resp.Keds.KED
 	vhbs := resp.Vhbs.VHB
 	ars := resp.Ars.AR
 	for index, ked := range keds {
-		ks := codec.ECBDecryptNoPad(ked, b.KD)
-		aes := codec.NewBlockAESEncryptor(b, ks)
+		aes := codec.NewDupAESEncryptor(b, ked)
+		if aes == nil {
+			continue
+		}
 		eblk, err := aes.Encrypt()
 		if err != nil {
 			logrus.Warnf("[UploadObjectToDisk][%s/%s]CheckBlockDup ERR:%s\n", ud.Bucket, ud.ObjectKey, err)
-			return nil, nil
+			return nil, nil, 0
 		}
 		var vhb []byte
 		if eblk.NeedEncode() {
 			if ars[index] == codec.AR_RS_MODE {
 				logrus.Warnf("[UploadObjectToDisk][%s/%s]CheckBlockDup ERR:RS Not supported\n", ud.Bucket, ud.ObjectKey)
-				return nil, nil
+				return nil, nil, 0
 			} else {
 				enc := codec.NewErasureEncoder(eblk)
 				err = enc.Encode()
 				if err != nil {
 					logrus.Warnf("[UploadObjectToDisk][%s/%s]CheckBlockDup ERR:%s\n", ud.Bucket, ud.ObjectKey, err)
-					return nil, nil
+					return nil, nil, 0
 				}
 				vhb = eblk.VHB
 			}
@@ -183,15 +185,15 @@ func (ud *UploadObjectToDisk) CheckBlockDup(resp *pkt.UploadBlockDupResp, b *cod
 			err = eblk.MakeVHB()
 			if err != nil {
 				logrus.Warnf("[UploadObjectToDisk][%s/%s]CheckBlockDup ERR:%s\n", ud.Bucket, ud.ObjectKey, err)
-				return nil, nil
+				return nil, nil, 0
 			}
 			vhb = eblk.VHB
 		}
 		if bytes.Equal(vhb, vhbs[index]) {
-			return codec.ECBEncryptNoPad(ks, ud.UClient.StoreKey.AESKey), vhb
+			return codec.ECBEncryptNoPad(eblk.SecretKey, ud.UClient.StoreKey.AESKey), vhb, eblk.RealSize
 		}
 	}
-	return nil, nil
+	return nil, nil, 0
 }
 
 var pathmap sync.Map
@@ -5,12 +5,15 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/md5"
+	crand "crypto/rand"
 	"crypto/sha256"
 	"encoding/binary"
 	"errors"
+	"io"
 	"math/rand"
 	"time"
 
+	"github.com/yottachain/YTCoreService/env"
 	"github.com/yottachain/YTCrypto"
 )
 
@@ -52,15 +55,59 @@ func GenerateUserKey(bs []byte) []byte {
 	}
 }
 
+// Block formats. CBC blocks carry no header and share IVParameter; GCM
+// blocks start with gcmMagic, the format byte and a random nonce. The
+// format is also declared in the plain block header and recorded in the
+// refer, see Refer.Format.
+const (
+	BlockFormatCBC uint8 = 1
+	BlockFormatGCM uint8 = 2
+)
+
+// BlockFormat returns the format new blocks are encrypted in, GCM unless
+// configured otherwise.
+func BlockFormat() uint8 {
+	if env.BlockFormat == "cbc" {
+		return BlockFormatCBC
+	}
+	return BlockFormatGCM
+}
+
+var gcmMagic = []byte{'Y', 'T', 'B'}
+
+const gcmHeaderSize = 16
+const gcmNonceSize = 12
+
+func IsGCMBlock(data []byte) bool {
+	return len(data) >= gcmHeaderSize+16 && len(data)%16 == 0 &&
+		bytes.Equal(data[0:3], gcmMagic) && data[3] == BlockFormatGCM
+}
+
 type BlockAESEncryptor struct {
 	plainBlock *PlainBlock
 	secretKey  []byte
+	Format     uint8
+	Nonce      []byte
 }
 
 func NewBlockAESEncryptor(b *PlainBlock, key []byte) *BlockAESEncryptor {
 	bae := new(BlockAESEncryptor)
 	bae.plainBlock = b
 	bae.secretKey = key
+	bae.Format = b.Format()
+	return bae
+}
+
+// NewDupAESEncryptor returns an encryptor that encrypts b the way the
+// stored duplicate whose KED is ked was encrypted, nil if the duplicate is
+// in another format than b.
+func NewDupAESEncryptor(b *PlainBlock, ked []byte) *BlockAESEncryptor {
+	if KEDBlockFormat(ked) != b.Format() {
+		return nil
+	}
+	ks, nonce := OpenKED(ked, b.KD)
+	bae := NewBlockAESEncryptor(b, ks)
+	bae.Nonce = nonce
 	return bae
 }
 
@@ -72,13 +119,42 @@ func (bae *BlockAESEncryptor) Encrypt() (*EncryptedBlock, error) {
 	if err != nil {
 		return nil, err
 	}
-	srcData := PKCS7Padding(bae.plainBlock.Data, 16)
-	blockMode := cipher.NewCBCEncrypter(block, IVParameter)
-	dstData := make([]byte, len(srcData))
-	blockMode.CryptBlocks(dstData, srcData)
 	encryptedBlock := new(EncryptedBlock)
 	encryptedBlock.SecretKey = bae.secretKey
-	encryptedBlock.Data = dstData
+	encryptedBlock.Format = bae.Format
+	if bae.Format == BlockFormatCBC {
+		srcData := PKCS7Padding(bae.plainBlock.Data, 16)
+		blockMode := cipher.NewCBCEncrypter(block, IVParameter)
+		dstData := make([]byte, len(srcData))
+		blockMode.CryptBlocks(dstData, srcData)
+		encryptedBlock.Data = dstData
+		encryptedBlock.RealSize = bae.plainBlock.Length()
+		return encryptedBlock, nil
+	}
+	if bae.Format != BlockFormatGCM {
+		return nil, errors.New("unknown block format")
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := bae.Nonce
+	if nonce == nil {
+		nonce = make([]byte, gcmNonceSize)
+		if _, err = io.ReadFull(crand.Reader, nonce); err != nil {
+			return nil, err
+		}
+	} else if len(nonce) != gcmNonceSize {
+		return nil, errors.New("invalid nonce")
+	}
+	header := make([]byte, gcmHeaderSize, gcmHeaderSize+len(bae.plainBlock.Data)+32)
+	copy(header, gcmMagic)
+	header[3] = BlockFormatGCM
+	copy(header[4:], nonce)
+	srcData := PKCS7Padding(bae.plainBlock.Data, 16)
+	encryptedBlock.Data = gcm.Seal(header, header[4:gcmHeaderSize], srcData, header[0:4])
+	encryptedBlock.Nonce = nonce
+	encryptedBlock.RealSize = encryptedBlock.Length() - 16
 	return encryptedBlock, nil
 }
 
@@ -92,6 +168,8 @@ func NewBlockAESDecryptor(b *EncryptedBlock) *BlockAESDecryptor {
 	return bae
 }
 
+// Decrypt decrypts the block in the format set on it, taken from its
+// refer; blocks whose refer records no format are CBC.
 func (bae *BlockAESDecryptor) Decrypt() (*PlainBlock, error) {
 	if bae.encryptedBlock.Data == nil {
 		return nil, errors.New("decrypt:data is null")
@@ -100,18 +178,59 @@ func (bae *BlockAESDecryptor) Decrypt() (*PlainBlock, error) {
 		return nil, errors.New("SecretKey is null")
 	}
 	length := len(bae.encryptedBlock.Data)
-	if length%16 > 0 {
+	if length == 0 || length%16 > 0 {
 		return nil, errors.New("data err")
 	}
 	block, err := aes.NewCipher(bae.encryptedBlock.SecretKey)
 	if err != nil {
 		return nil, err
 	}
-	blockMode := cipher.NewCBCDecrypter(block, IVParameter)
-	dstData := make([]byte, length)
-	blockMode.CryptBlocks(dstData, bae.encryptedBlock.Data)
+	var plainBlock *PlainBlock
+	format := bae.encryptedBlock.Format
+	if format == BlockFormatGCM {
+		if !IsGCMBlock(bae.encryptedBlock.Data) {
+			return nil, errors.New("data err")
+		}
+		plainBlock, err = decryptGCM(block, bae.encryptedBlock.Data)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		format = BlockFormatCBC
+		blockMode := cipher.NewCBCDecrypter(block, IVParameter)
+		dstData := make([]byte, length)
+		blockMode.CryptBlocks(dstData, bae.encryptedBlock.Data)
+		padding := int(dstData[length-1])
+		if padding == 0 || padding > 16 {
+			return nil, errors.New("data err")
+		}
+		plainBlock = new(PlainBlock)
+		plainBlock.Data = dstData[:length-padding]
+	}
+	if plainBlock.Format() != format {
+		return nil, errors.New("block format mismatch")
+	}
+	return plainBlock, nil
+}
+
+func decryptGCM(block cipher.Block, data []byte) (*PlainBlock, error) {
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	dstData, err := gcm.Open(nil, data[4:gcmHeaderSize], data[gcmHeaderSize:], data[0:4])
+	if err != nil {
+		return nil, errors.New("block authentication failed")
+	}
+	if len(dstData) == 0 {
+		return nil, errors.New("data err")
+	}
+	padding := int(dstData[len(dstData)-1])
+	if padding == 0 || padding > 16 || padding > len(dstData) {
+		return nil, errors.New("data err")
+	}
 	plainBlock := new(PlainBlock)
-	plainBlock.Data = PKCS7UnPadding(dstData)
+	plainBlock.Data = dstData[:len(dstData)-padding]
 	return plainBlock, nil
 }
 
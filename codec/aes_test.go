@@ -0,0 +1,216 @@
+package codec
+
+import (
+	"bytes"
+	"testing"
+)
+
+func testPlainBlock(t *testing.T, format uint8, data []byte) *PlainBlock {
+	buf := bytes.NewBuffer(nil)
+	writeBlockHead(buf, format, HeadNone)
+	buf.Write(data)
+	b := NewPlainBlock(buf.Bytes(), int64(len(data)))
+	if err := b.Sum(); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func readPlainBlock(t *testing.T, b *PlainBlock) []byte {
+	r, err := NewBlockReader(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := bytes.NewBuffer(nil)
+	buf := make([]byte, 100)
+	for {
+		n, err := r.Read(buf)
+		out.Write(buf[0:n])
+		if err != nil {
+			break
+		}
+	}
+	return out.Bytes()
+}
+
+func TestBlockAESRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		format uint8
+		size   int
+	}{
+		{"cbc empty", BlockFormatCBC, 0},
+		{"cbc aligned", BlockFormatCBC, 30},
+		{"cbc", BlockFormatCBC, 1000},
+		{"gcm empty", BlockFormatGCM, 0},
+		{"gcm aligned", BlockFormatGCM, 29},
+		{"gcm", BlockFormatGCM, 1000},
+	}
+	for _, tt := range tests {
+		data := bytes.Repeat([]byte{0x5a}, tt.size)
+		b := testPlainBlock(t, tt.format, data)
+		if b.Format() != tt.format {
+			t.Fatalf("%s: header declares format %d", tt.name, b.Format())
+		}
+		eb, err := NewBlockAESEncryptor(b, GenerateRandomKey()).Encrypt()
+		if err != nil {
+			t.Fatalf("%s: encrypt: %s", tt.name, err)
+		}
+		if int64(len(eb.Data)) != b.GetEncryptedBlockSize() {
+			t.Errorf("%s: encrypted %d bytes, expected %d", tt.name, len(eb.Data), b.GetEncryptedBlockSize())
+		}
+		if GetEncryptedBlockSize(eb.RealSize) != int64(len(eb.Data)) {
+			t.Errorf("%s: RealSize %d does not give the encrypted size %d", tt.name, eb.RealSize, len(eb.Data))
+		}
+		if IsGCMBlock(eb.Data) != (tt.format == BlockFormatGCM) {
+			t.Errorf("%s: IsGCMBlock does not match the format", tt.name)
+		}
+		pb, err := NewBlockAESDecryptor(eb).Decrypt()
+		if err != nil {
+			t.Fatalf("%s: decrypt: %s", tt.name, err)
+		}
+		if !bytes.Equal(pb.Data, b.Data) {
+			t.Fatalf("%s: plain block changed", tt.name)
+		}
+		if !bytes.Equal(readPlainBlock(t, pb), data) {
+			t.Errorf("%s: data changed", tt.name)
+		}
+	}
+}
+
+func TestBlockAESGCMRejects(t *testing.T) {
+	b := testPlainBlock(t, BlockFormatGCM, []byte("authenticated block data"))
+	eb, err := NewBlockAESEncryptor(b, GenerateRandomKey()).Encrypt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tamper := func(pos int) func(*EncryptedBlock) {
+		return func(e *EncryptedBlock) { e.Data[pos] ^= 0x01 }
+	}
+	tests := []struct {
+		name   string
+		change func(*EncryptedBlock)
+	}{
+		{"header", tamper(1)},
+		{"nonce", tamper(5)},
+		{"ciphertext", tamper(gcmHeaderSize + 3)},
+		{"tag", tamper(len(eb.Data) - 1)},
+		{"key", func(e *EncryptedBlock) { e.SecretKey = GenerateRandomKey() }},
+		{"read as cbc", func(e *EncryptedBlock) { e.Format = 0 }},
+	}
+	for _, tt := range tests {
+		e := &EncryptedBlock{SecretKey: eb.SecretKey, Format: eb.Format}
+		e.Data = append([]byte{}, eb.Data...)
+		tt.change(e)
+		if _, err := NewBlockAESDecryptor(e).Decrypt(); err == nil {
+			t.Errorf("%s: tampered block decrypted", tt.name)
+		}
+	}
+}
+
+func TestBlockAESCBCReadAsGCM(t *testing.T) {
+	b := testPlainBlock(t, BlockFormatCBC, []byte("legacy block data"))
+	eb, err := NewBlockAESEncryptor(b, GenerateRandomKey()).Encrypt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	eb.Format = BlockFormatGCM
+	if _, err := NewBlockAESDecryptor(eb).Decrypt(); err == nil {
+		t.Error("CBC block decrypted as GCM")
+	}
+}
+
+func TestBlockAESNonce(t *testing.T) {
+	b := testPlainBlock(t, BlockFormatGCM, []byte("same data, same key"))
+	ks := GenerateRandomKey()
+	eb1, err := NewBlockAESEncryptor(b, ks).Encrypt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	eb2, err := NewBlockAESEncryptor(b, ks).Encrypt()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(eb1.Nonce, eb2.Nonce) || bytes.Equal(eb1.Data, eb2.Data) {
+		t.Fatal("nonce is not random")
+	}
+	if !bytes.Equal(eb1.Nonce, eb1.Data[4:gcmHeaderSize]) {
+		t.Fatal("nonce is not stored in the block")
+	}
+}
+
+func TestDupAESEncryptor(t *testing.T) {
+	tests := []struct {
+		name    string
+		stored  uint8
+		current uint8
+		match   bool
+	}{
+		{"cbc", BlockFormatCBC, BlockFormatCBC, true},
+		{"gcm", BlockFormatGCM, BlockFormatGCM, true},
+		{"gcm stored as cbc", BlockFormatCBC, BlockFormatGCM, false},
+		{"cbc stored as gcm", BlockFormatGCM, BlockFormatCBC, false},
+	}
+	data := []byte("duplicated block data")
+	for _, tt := range tests {
+		stored := testPlainBlock(t, tt.stored, data)
+		eb, err := NewBlockAESEncryptor(stored, GenerateRandomKey()).Encrypt()
+		if err != nil {
+			t.Fatal(err)
+		}
+		eb.MakeVHB()
+		ked := eb.MakeKED(stored.KD)
+		if KEDBlockFormat(ked) != tt.stored {
+			t.Fatalf("%s: KED of %d bytes gives format %d", tt.name, len(ked), KEDBlockFormat(ked))
+		}
+		current := testPlainBlock(t, tt.current, data)
+		bae := NewDupAESEncryptor(current, ked)
+		if !tt.match {
+			if bae != nil {
+				t.Errorf("%s: duplicate in another format accepted", tt.name)
+			}
+			continue
+		}
+		if bae == nil {
+			t.Fatalf("%s: duplicate rejected", tt.name)
+		}
+		dup, err := bae.Encrypt()
+		if err != nil {
+			t.Fatal(err)
+		}
+		dup.MakeVHB()
+		if !bytes.Equal(dup.VHB, eb.VHB) || dup.RealSize != eb.RealSize {
+			t.Errorf("%s: VHB of the duplicate not reproduced", tt.name)
+		}
+	}
+}
+
+func TestReadBlockHead(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   []byte
+		format uint8
+		head   int16
+		size   int
+		err    bool
+	}{
+		{"legacy raw", []byte{0xFF, 0xFF, 1}, BlockFormatCBC, HeadNone, 2, false},
+		{"legacy zlib", []byte{0x00, 0x00, 1}, BlockFormatCBC, HeadZlib, 2, false},
+		{"legacy zlib tail", []byte{0x3F, 0xFF, 1}, BlockFormatCBC, 0x3FFF, 2, false},
+		{"legacy zstd", []byte{0xFF, 0xFE, 1}, BlockFormatCBC, HeadZstd, 2, false},
+		{"gcm raw", []byte{0x82, 0xFF, 0xFF, 1}, BlockFormatGCM, HeadNone, 3, false},
+		{"gcm lz4", []byte{0x82, 0xFF, 0xFD}, BlockFormatGCM, HeadLZ4, 3, false},
+		{"short", []byte{0xFF}, 0, 0, 0, true},
+		{"short gcm", []byte{0x82, 0xFF}, 0, 0, 0, true},
+	}
+	for _, tt := range tests {
+		format, head, size, err := ReadBlockHead(tt.data)
+		if (err != nil) != tt.err {
+			t.Errorf("%s: error %v", tt.name, err)
+			continue
+		}
+		if format != tt.format || head != tt.head || size != tt.size {
+			t.Errorf("%s: got format %d head %d size %d", tt.name, format, head, size)
+		}
+	}
+}
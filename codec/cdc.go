@@ -0,0 +1,107 @@
+package codec
+
+import (
+	"io"
+	"math/bits"
+
+	"github.com/yottachain/YTCoreService/env"
+)
+
+// gearTable drives the FastCDC rolling hash. It must never change, or the
+// chunks of unchanged data would no longer dedupe against earlier uploads.
+var gearTable [256]uint64
+
+func init() {
+	seed := uint64(0x59545443444331)
+	for ii := range gearTable {
+		seed += 0x9E3779B97F4A7C15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+		z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+		gearTable[ii] = z ^ (z >> 31)
+	}
+}
+
+// Chunker cuts data at content-defined points (FastCDC with normalized
+// chunking), so an insert only moves the boundaries near it.
+type Chunker struct {
+	min   int
+	avg   int
+	max   int
+	maskS uint64
+	maskL uint64
+}
+
+// NewChunker returns a chunker whose chunks fit into one block: max is the
+// raw capacity of a block, avg the power of two not above avgSize or max/2,
+// and min avg/2.
+func NewChunker(avgSize int64) *Chunker {
+	max := int(env.Default_Block_Size) - BlockHeadSize(BlockFormat())
+	if avgSize > int64(max/2) {
+		avgSize = int64(max / 2)
+	}
+	if avgSize < 64 {
+		avgSize = 64
+	}
+	n := bits.Len64(uint64(avgSize)) - 1
+	c := &Chunker{avg: 1 << n, max: max}
+	c.min = c.avg / 2
+	c.maskS = ^uint64(0) << (64 - n - 2)
+	c.maskL = ^uint64(0) << (64 - n + 2)
+	return c
+}
+
+func (c *Chunker) MaxSize() int {
+	return c.max
+}
+
+// Cut returns the length of the first chunk of data. Data shorter than max
+// is taken to be the end of the stream.
+func (c *Chunker) Cut(data []byte) int {
+	n := len(data)
+	if n <= c.min {
+		return n
+	}
+	if n > c.max {
+		n = c.max
+	}
+	normal := c.avg
+	if normal > n {
+		normal = n
+	}
+	var fp uint64
+	ii := c.min
+	for ; ii < normal; ii++ {
+		fp = (fp << 1) + gearTable[data[ii]]
+		if fp&c.maskS == 0 {
+			return ii + 1
+		}
+	}
+	for ; ii < n; ii++ {
+		fp = (fp << 1) + gearTable[data[ii]]
+		if fp&c.maskL == 0 {
+			return ii + 1
+		}
+	}
+	return n
+}
+
+// nextChunk looks ahead for the next chunk boundary and limits the block
+// to it, leaving the reader where it was.
+func (fileEncoder *FileEncoder) nextChunk() error {
+	if fileEncoder.chunker == nil {
+		fileEncoder.chunker = NewChunker(env.CDCAvgSize)
+		fileEncoder.chunkBuf = make([]byte, fileEncoder.chunker.MaxSize())
+	}
+	num, err := io.ReadFull(fileEncoder.reader, fileEncoder.chunkBuf)
+	if err != nil && !(err == io.EOF || err == io.ErrUnexpectedEOF) {
+		return err
+	}
+	if num > 0 {
+		if _, err = fileEncoder.reader.Seek(-int64(num), io.SeekCurrent); err != nil {
+			return err
+		}
+	}
+	fileEncoder.chunk = int64(fileEncoder.chunker.Cut(fileEncoder.chunkBuf[0:num]))
+	return nil
+}
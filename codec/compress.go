@@ -0,0 +1,124 @@
+package codec
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/yottachain/YTCoreService/env"
+)
+
+// The two-byte block header records how the block was packed. A header >= 0
+// is the legacy zlib format, whose value is the length of the raw tail
+// following the zlib stream; -1 is raw data. Other codecs take the
+// remaining negative values, which readers that predate them take for raw
+// data, so zlib stays the default CompressCodec until every reader is
+// upgraded.
+const (
+	HeadNone int16 = -1
+	HeadZlib int16 = 0
+	HeadZstd int16 = -2
+	HeadLZ4  int16 = -3
+)
+
+const (
+	compressOverhead = 64
+	compressMinChunk = 1024
+	compressMaxRatio = 8
+	compressProbe    = 0.1
+)
+
+type CompressWriter interface {
+	io.WriteCloser
+	Flush() error
+}
+
+type Compressor struct {
+	Name      string
+	Head      int16
+	NewWriter func(w io.Writer) (CompressWriter, error)
+	NewReader func(data []byte) (io.Reader, error)
+}
+
+var compressors = make(map[string]*Compressor)
+var compressorHeads = make(map[int16]*Compressor)
+
+func RegisterCompressor(c *Compressor) {
+	compressors[c.Name] = c
+	compressorHeads[c.Head] = c
+}
+
+// GetCompressor returns the compressor configured by name, nil for none.
+func GetCompressor(name string) *Compressor {
+	return compressors[name]
+}
+
+func GetCompressorByHead(head int16) *Compressor {
+	return compressorHeads[head]
+}
+
+// Compressible probes a sample of the data, reporting false for data such
+// as media or archives that would not pay off compressing.
+func Compressible(sample []byte) bool {
+	return compress.Estimate(sample) >= compressProbe
+}
+
+var zstdDecoder *zstd.Decoder
+var zstdErr error
+var zstdOnce sync.Once
+
+func init() {
+	RegisterCompressor(&Compressor{
+		Name: "zlib",
+		Head: HeadZlib,
+		NewWriter: func(w io.Writer) (CompressWriter, error) {
+			return zlib.NewWriter(w), nil
+		},
+		NewReader: func(data []byte) (io.Reader, error) {
+			return zlib.NewReader(bytes.NewReader(data))
+		},
+	})
+	RegisterCompressor(&Compressor{
+		Name: "zstd",
+		Head: HeadZstd,
+		NewWriter: func(w io.Writer) (CompressWriter, error) {
+			return zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+		},
+		NewReader: func(data []byte) (io.Reader, error) {
+			zstdOnce.Do(func() {
+				zstdDecoder, zstdErr = zstd.NewReader(nil)
+			})
+			if zstdErr != nil {
+				return nil, zstdErr
+			}
+			bs, err := zstdDecoder.DecodeAll(data, nil)
+			if err != nil {
+				return nil, err
+			}
+			return bytes.NewReader(bs), nil
+		},
+	})
+	RegisterCompressor(&Compressor{
+		Name: "lz4",
+		Head: HeadLZ4,
+		NewWriter: func(w io.Writer) (CompressWriter, error) {
+			lw := lz4.NewWriter(w)
+			err := lw.Apply(lz4.BlockSizeOption(lz4.Block64Kb))
+			return lw, err
+		},
+		NewReader: func(data []byte) (io.Reader, error) {
+			return lz4.NewReader(bytes.NewReader(data)), nil
+		},
+	})
+}
+
+func defaultCompressor() *Compressor {
+	if !env.Compress {
+		return nil
+	}
+	return GetCompressor(env.CompressCodec)
+}
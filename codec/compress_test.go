@@ -0,0 +1,81 @@
+package codec
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"github.com/yottachain/YTCoreService/env"
+)
+
+// testText returns size bytes of log-like text that compresses well.
+func testText(size int) []byte {
+	words := []string{"upload", "block", "shard", "node", "bucket", "object", "ok", "retry", "timeout", "sn"}
+	rnd := rand.New(rand.NewSource(1))
+	buf := bytes.NewBuffer(nil)
+	for buf.Len() < size {
+		fmt.Fprintf(buf, "%d [%s] %s %s id=%d\n", rnd.Intn(100000), words[rnd.Intn(len(words))],
+			words[rnd.Intn(len(words))], words[rnd.Intn(len(words))], rnd.Intn(1000))
+	}
+	return buf.Bytes()[0:size]
+}
+
+// TestCompressRatio checks that compressed blocks stay about as small as a
+// single stream of the same codec over the whole input.
+func TestCompressRatio(t *testing.T) {
+	defer func(compress bool, codec string) {
+		env.Compress, env.CompressCodec = compress, codec
+	}(env.Compress, env.CompressCodec)
+	env.Compress = true
+	data := testText(8 * 1024 * 1024)
+	for _, name := range []string{"zlib", "zstd", "lz4"} {
+		env.CompressCodec = name
+		c := GetCompressor(name)
+		stream := bytes.NewBuffer(nil)
+		w, err := c.NewWriter(stream)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write(data)
+		if err = w.Close(); err != nil {
+			t.Fatal(err)
+		}
+		enc, err := NewBytesEncoder(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var size, blocks int
+		out := bytes.NewBuffer(nil)
+		for {
+			b, err := enc.ReadNext()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if b == nil {
+				break
+			}
+			if int64(len(b.Data)) > env.Default_Block_Size {
+				t.Fatalf("%s: block of %d bytes", name, len(b.Data))
+			}
+			r, err := NewBlockReader(b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bs, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatalf("%s: %s", name, err)
+			}
+			out.Write(bs)
+			size, blocks = size+len(b.Data), blocks+1
+		}
+		if !bytes.Equal(out.Bytes(), data) {
+			t.Fatalf("%s: blocks decode to other data", name)
+		}
+		limit := stream.Len() + stream.Len()/100 + blocks*(BlockHeadSize(BlockFormat())+compressOverhead)
+		if size > limit {
+			t.Errorf("%s: %d bytes in %d blocks, a single stream takes %d", name, size, blocks, stream.Len())
+		}
+	}
+}
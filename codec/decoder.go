@@ -2,6 +2,10 @@ package codec
 
 import (
 	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"hash/crc32"
 	"io"
 	"os"
 
@@ -16,6 +20,8 @@ type Decoder struct {
 	reader       io.Reader
 	file         *os.File
 	pos          int64
+	keyPos       int64
+	version      int
 	readin       int64
 	UserId       uint32
 	KeyNumber    uint32
@@ -67,34 +73,29 @@ func (dec *Decoder) Close() {
 }
 
 func (dec *Decoder) ReadNextKey() (string, error) {
-	if dec.readin < dec.pos {
+	if dec.readin < dec.keyPos {
 		dec.Close()
 		f, err := os.OpenFile(dec.path, os.O_RDONLY, 0644)
 		if err != nil {
 			return "", err
 		}
 		dec.file = f
-		_, err = dec.file.Seek(dec.pos, io.SeekStart)
+		_, err = dec.file.Seek(dec.keyPos, io.SeekStart)
 		if err != nil {
 			return "", err
 		}
-		dec.readin = dec.pos
+		dec.readin = dec.keyPos
 		dec.reader = bufio.NewReader(dec.file)
 	}
-	ii, err := ReadInt32(dec.reader)
+	key, n, err := readKey(dec.reader, dec.version)
 	if err != nil {
 		if err == io.EOF {
 			return "", nil
 		}
 		return "", err
 	}
-	bs := make([]byte, ii)
-	err = ReadFull(dec.reader, bs)
-	if err != nil {
-		return "", err
-	}
-	dec.readin = dec.readin + 4 + int64(ii)
-	return string(bs), nil
+	dec.readin = dec.readin + n
+	return key, nil
 }
 
 func (dec *Decoder) HasNextBlock() bool {
@@ -120,6 +121,25 @@ func (dec *Decoder) ReadNext() (*EncodedBlock, error) {
 }
 
 func (dec *Decoder) NextBlock() (*EncodedBlock, error) {
+	if dec.version == 0 {
+		return dec.nextBlock()
+	}
+	src := dec.reader
+	crc := crc32.NewIEEE()
+	dec.reader = io.TeeReader(src, crc)
+	b, err := dec.nextBlock()
+	dec.reader = src
+	if err != nil {
+		return nil, err
+	}
+	if err = readCRC(dec.reader, crc.Sum32()); err != nil {
+		return nil, errors.New("encoded block corrupted")
+	}
+	dec.readin = dec.readin + 4
+	return b, nil
+}
+
+func (dec *Decoder) nextBlock() (*EncodedBlock, error) {
 	b, err := ReadBool(dec.reader)
 	if err != nil {
 		return nil, err
@@ -145,6 +165,16 @@ func (dec *Decoder) NextNODupBlock() (*EncodedBlock, error) {
 	if err != nil {
 		return nil, err
 	}
+	kedsize := KEDSize
+	if dec.version >= 2 {
+		bs := make([]byte, 1)
+		err = ReadFull(dec.reader, bs)
+		if err != nil {
+			return nil, err
+		}
+		kedsize = int(bs[0])
+		dec.readin = dec.readin + 1
+	}
 	bs1 := make([]byte, 32)
 	err = ReadFull(dec.reader, bs1)
 	if err != nil {
@@ -155,7 +185,7 @@ func (dec *Decoder) NextNODupBlock() (*EncodedBlock, error) {
 	if err != nil {
 		return nil, err
 	}
-	bs3 := make([]byte, 32)
+	bs3 := make([]byte, kedsize)
 	err = ReadFull(dec.reader, bs3)
 	if err != nil {
 		return nil, err
@@ -165,7 +195,7 @@ func (dec *Decoder) NextNODupBlock() (*EncodedBlock, error) {
 	if err != nil {
 		return nil, err
 	}
-	dec.readin = dec.readin + 8 + 8 + 8 + 32 + 32 + 32 + ii3
+	dec.readin = dec.readin + 8 + 8 + 8 + 32 + 32 + int64(kedsize) + ii3
 	b := &EncodedBlock{OriginalSize: ii1, RealSize: ii2, VHP: bs1, KEU: bs2, KED: bs3, DATA: bs4, IsDup: false}
 	return b, nil
 }
@@ -211,47 +241,100 @@ func (dec *Decoder) readHead() error {
 	shastr := SimpleName(f.Name())
 	dec.sha = base58.Decode(shastr)
 	dec.reader = bufio.NewReader(f)
-	pos, err := ReadInt64(dec.reader)
+	stat, err := f.Stat()
 	if err != nil {
 		return err
 	}
-	dec.pos = pos
-	ii, err := ReadInt64(dec.reader)
+	dec.version, dec.pos, err = readPrefix(dec.reader)
 	if err != nil {
 		return err
 	}
+	dec.keyPos = keysOffset(dec.version, dec.pos)
+	if dec.version == 0 {
+		size, err := dec.readHeadFields(dec.reader)
+		if err != nil {
+			return err
+		}
+		dec.readin = 8 + size
+	} else {
+		crc := crc32.NewIEEE()
+		size, err := dec.readHeadFields(io.TeeReader(dec.reader, crc))
+		if err != nil {
+			return err
+		}
+		if err = readCRC(dec.reader, crc.Sum32()); err != nil {
+			return errors.New("encoded file header corrupted")
+		}
+		dec.readin = prefixSize + size + 4
+	}
+	if dec.pos < dec.readin || dec.keyPos > stat.Size() {
+		return errors.New("encoded file truncated")
+	}
+	if dec.version > 0 {
+		return dec.verify()
+	}
+	return nil
+}
+
+// verify checks the trailer digest, so a damaged file fails before any
+// block is uploaded.
+func (dec *Decoder) verify() error {
+	bs := make([]byte, trailerSize)
+	if _, err := dec.file.ReadAt(bs, dec.pos); err != nil {
+		return err
+	}
+	if string(bs[0:4]) != trailerMagic {
+		return errors.New("encoded file trailer not found")
+	}
+	digest := sha256.New()
+	if _, err := io.Copy(digest, io.NewSectionReader(dec.file, prefixSize, dec.pos-prefixSize)); err != nil {
+		return err
+	}
+	if !bytes.Equal(digest.Sum(nil), bs[4:]) {
+		return errors.New("encoded file corrupted")
+	}
+	return nil
+}
+
+func (dec *Decoder) readHeadFields(r io.Reader) (int64, error) {
+	ii, err := ReadInt64(r)
+	if err != nil {
+		return 0, err
+	}
 	dec.length = ii
 	bs := make([]byte, 16)
-	err = ReadFull(dec.reader, bs)
+	err = ReadFull(r, bs)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	dec.md5 = bs
-	i, err := ReadInt32(dec.reader)
+	i, err := ReadInt32(r)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	dec.UserId = uint32(i)
-	i, err = ReadInt32(dec.reader)
+	i, err = ReadInt32(r)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	dec.KeyNumber = uint32(i)
-	i, err = ReadInt32(dec.reader)
+	i, err = ReadInt32(r)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	dec.StoreNumber = uint32(i)
-	i, err = ReadInt32(dec.reader)
+	i, err = ReadInt32(r)
 	if err != nil {
-		return err
+		return 0, err
+	}
+	if i < 0 {
+		return 0, errors.New("invalid sign length")
 	}
 	bss := make([]byte, i)
-	err = ReadFull(dec.reader, bss)
+	err = ReadFull(r, bss)
 	if err != nil {
-		return err
+		return 0, err
 	}
 	dec.Sign = string(bss)
-	dec.readin = 8 + 8 + 16 + 4 + 4 + 4 + 4 + int64(len(bss))
-	return nil
+	return 8 + 16 + 4 + 4 + 4 + 4 + int64(len(bss)), nil
 }
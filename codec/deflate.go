@@ -2,7 +2,6 @@ package codec
 
 import (
 	"bytes"
-	"compress/zlib"
 	"crypto/md5"
 	"crypto/sha256"
 	"errors"
@@ -21,6 +20,9 @@ type FileEncoder struct {
 	reader       io.ReadSeeker
 	curBlock     *PlainBlock
 	md5          []byte
+	chunker      *Chunker
+	chunkBuf     []byte
+	chunk        int64
 }
 
 func NewBytesEncoder(bs []byte) (*FileEncoder, error) {
@@ -115,14 +117,34 @@ func (fileEncoder *FileEncoder) HasNext() (bool, error) {
 		fileEncoder.Close()
 		return false, nil
 	}
-	if !env.Compress {
+	if r, ok := fileEncoder.reader.(*StreamReader); ok {
+		r.Mark()
+	}
+	if env.CDC {
+		if err := fileEncoder.nextChunk(); err != nil {
+			fileEncoder.Close()
+			return false, err
+		}
+	}
+	c := defaultCompressor()
+	if c != nil {
+		compressible, err := fileEncoder.probe()
+		if err != nil {
+			fileEncoder.Close()
+			return false, err
+		}
+		if !compressible {
+			c = nil
+		}
+	}
+	if c == nil {
 		err2 := fileEncoder.pack()
 		if err2 != nil {
 			fileEncoder.Close()
 			return false, err2
 		}
 	} else {
-		readTotal, err := fileEncoder.deflate()
+		readTotal, err := fileEncoder.compress(c)
 		if err != nil {
 			fileEncoder.Close()
 			return false, err
@@ -142,6 +164,9 @@ func (fileEncoder *FileEncoder) HasNext() (bool, error) {
 	}
 	if fileEncoder.finished {
 		fileEncoder.Close()
+		if err := fileEncoder.streamEnd(); err != nil {
+			return false, err
+		}
 	}
 	if fileEncoder.curBlock == nil {
 		return false, nil
@@ -152,9 +177,12 @@ func (fileEncoder *FileEncoder) HasNext() (bool, error) {
 
 func (fileEncoder *FileEncoder) pack() error {
 	buf := bytes.NewBuffer(nil)
-	size := -1
-	buf.Write([]byte{uint8(size >> 8), uint8(size)})
-	data := make([]byte, env.Default_Block_Size-2)
+	writeBlockHead(buf, BlockFormat(), HeadNone)
+	size := env.Default_Block_Size - int64(buf.Len())
+	if fileEncoder.chunk > 0 && fileEncoder.chunk < size {
+		size = fileEncoder.chunk
+	}
+	data := make([]byte, size)
 	num, err := io.ReadFull(fileEncoder.reader, data)
 	if err != nil && !(err == io.EOF || err == io.ErrUnexpectedEOF) {
 		return err
@@ -162,7 +190,7 @@ func (fileEncoder *FileEncoder) pack() error {
 	if num > 0 {
 		buf.Write(data[0:num])
 		fileEncoder.curBlock = NewPlainBlock(buf.Bytes(), int64(num))
-		if err == io.EOF || err == io.ErrUnexpectedEOF || num < int(env.Default_Block_Size)-2 {
+		if err == io.EOF || err == io.ErrUnexpectedEOF || int64(num) < size {
 			fileEncoder.finished = true
 		}
 		fileEncoder.readinTotal = fileEncoder.readinTotal + int64(num)
@@ -173,74 +201,96 @@ func (fileEncoder *FileEncoder) pack() error {
 	return nil
 }
 
-func (fileEncoder *FileEncoder) deflate() (int64, error) {
+// probe reads a sample ahead of the block to skip compressing data that
+// would not shrink.
+func (fileEncoder *FileEncoder) probe() (bool, error) {
+	size := int64(env.READFILE_BUF_SIZE)
+	if fileEncoder.chunk > 0 && fileEncoder.chunk < size {
+		size = fileEncoder.chunk
+	}
+	sample := make([]byte, size)
+	num, err := io.ReadFull(fileEncoder.reader, sample)
+	if err != nil && !(err == io.EOF || err == io.ErrUnexpectedEOF) {
+		return false, err
+	}
+	if num > 0 {
+		if _, err = fileEncoder.reader.Seek(-int64(num), io.SeekCurrent); err != nil {
+			return false, err
+		}
+	}
+	return Compressible(sample[0:num]), nil
+}
+
+// compress fills a block with the output of c. Input still buffered by the
+// codec is counted at its full size, and c is only flushed once that
+// estimate leaves less than a read buffer of room, as every flush costs
+// compression. If it does not pay off, it returns the number of bytes read
+// for the caller to seek back and pack them instead.
+func (fileEncoder *FileEncoder) compress(c *Compressor) (int64, error) {
 	buf := bytes.NewBuffer(nil)
-	buf.Write([]byte{0, 0})
-	flateWrite := zlib.NewWriter(buf)
-	var err error
-	bs := make([]byte, 16)
-	var totalIn int64 = 0
-	var num int
-	for {
-		num, err = fileEncoder.reader.Read(bs)
-		if err != nil && err != io.EOF {
-			return 0, err
+	writeBlockHead(buf, BlockFormat(), c.Head)
+	w, err := c.NewWriter(buf)
+	if err != nil {
+		return 0, err
+	}
+	bs := make([]byte, env.READFILE_BUF_SIZE)
+	maxIn := env.Default_Block_Size * compressMaxRatio
+	if fileEncoder.chunk > 0 {
+		maxIn = fileEncoder.chunk
+	}
+	var totalIn, pending int64
+	eof := false
+	for !eof && totalIn < maxIn {
+		room := env.Default_Block_Size - int64(buf.Len()) - pending - compressOverhead
+		if room < int64(len(bs)) && pending > 0 {
+			if err = w.Flush(); err != nil {
+				return 0, err
+			}
+			pending = 0
+			room = env.Default_Block_Size - int64(buf.Len()) - compressOverhead
 		}
-		if err == io.EOF && num <= 0 {
+		if room < compressMinChunk {
 			break
 		}
-		totalIn = totalIn + int64(num)
-		flateWrite.Write(bs[0:num])
-		remainSize := env.Default_Block_Size - int64(buf.Len())
-		if remainSize < 0 {
-			return totalIn, nil
+		if room > int64(len(bs)) {
+			room = int64(len(bs))
 		}
-		if remainSize < env.Compress_Reserve_Size {
-			flateWrite.Close()
-			if totalIn-int64(buf.Len()) <= 0 {
-				return totalIn, nil
-			}
-			remainSize = env.Default_Block_Size - int64(buf.Len())
-			if remainSize < 0 {
-				return totalIn, nil
-			} else {
-				remainbs := make([]byte, remainSize)
-				num, err = fileEncoder.reader.Read(remainbs)
-				if err != nil && err != io.EOF {
-					return 0, err
-				}
-				if num > 0 {
-					totalIn = totalIn + int64(num)
-					buf.Write(remainbs[0:num])
-					data := buf.Bytes()
-					data[0] = uint8(num >> 8)
-					data[1] = uint8(num)
-					fileEncoder.curBlock = NewPlainBlock(data, totalIn)
-				} else {
-					fileEncoder.curBlock = NewPlainBlock(buf.Bytes(), totalIn)
-				}
-				if err == io.EOF {
-					fileEncoder.finished = true
-				}
-				fileEncoder.readinTotal = fileEncoder.readinTotal + totalIn
-				return 0, nil
+		if room > maxIn-totalIn {
+			room = maxIn - totalIn
+		}
+		num, err := io.ReadFull(fileEncoder.reader, bs[0:room])
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			eof = true
+		} else if err != nil {
+			return 0, err
+		}
+		if num > 0 {
+			totalIn = totalIn + int64(num)
+			pending = pending + int64(num)
+			if _, err = w.Write(bs[0:num]); err != nil {
+				return 0, err
 			}
 		}
 	}
-	fileEncoder.finished = true
 	if totalIn == 0 {
 		fileEncoder.curBlock = nil
+		fileEncoder.finished = true
 		return 0, nil
 	}
-	flateWrite.Close()
-	if totalIn-int64(buf.Len()) <= 0 {
+	if err = w.Close(); err != nil {
+		return 0, err
+	}
+	if totalIn-int64(buf.Len()) <= 0 || int64(buf.Len()) > env.Default_Block_Size {
 		return totalIn, nil
 	}
-	if int64(buf.Len()) > env.Default_Block_Size {
+	if fileEncoder.chunk > 0 && totalIn < fileEncoder.chunk && !eof {
 		return totalIn, nil
 	}
 	fileEncoder.curBlock = NewPlainBlock(buf.Bytes(), totalIn)
 	fileEncoder.readinTotal = fileEncoder.readinTotal + totalIn
+	if eof {
+		fileEncoder.finished = true
+	}
 	return 0, nil
 }
 
@@ -3,6 +3,7 @@ package codec
 import (
 	"bufio"
 	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"io"
 	"os"
@@ -64,11 +65,17 @@ func (enc *Encoder) Handle(out string) *pkt.ErrorMessage {
 		return pkt.NewErrorMsg(pkt.CODEC_ERROR, err.Error())
 	}
 	defer f.Close()
-	size, err := enc.writeHead(f)
+	err = writePrefix(f)
 	if err != nil {
 		return pkt.NewErrorMsg(pkt.CODEC_ERROR, err.Error())
 	}
-	var lastpos int64 = size
+	digest := sha256.New()
+	w := io.MultiWriter(f, digest)
+	size, err := enc.writeHead(w)
+	if err != nil {
+		return pkt.NewErrorMsg(pkt.CODEC_ERROR, err.Error())
+	}
+	var lastpos int64 = prefixSize + size
 	id := 0
 	for {
 		b, err := enc.fc.ReadNext()
@@ -89,9 +96,9 @@ func (enc *Encoder) Handle(out string) *pkt.ErrorMessage {
 			}
 			var werr error
 			if obj.IsDup {
-				size, werr = enc.writeDupBlock(f, obj)
+				size, werr = enc.writeDupBlock(w, obj)
 			} else {
-				size, werr = enc.writeNoDupBlock(f, obj)
+				size, werr = enc.writeNoDupBlock(w, obj)
 			}
 			if werr != nil {
 				return pkt.NewErrorMsg(pkt.SERVER_ERROR, werr.Error())
@@ -102,7 +109,11 @@ func (enc *Encoder) Handle(out string) *pkt.ErrorMessage {
 			lastpos = lastpos + size
 		}
 	}
-	err = enc.writeKey(f)
+	_, err = f.Write(append([]byte(trailerMagic), digest.Sum(nil)...))
+	if err != nil {
+		return pkt.NewErrorMsg(pkt.SERVER_ERROR, err.Error())
+	}
+	err = writeKey(enc.key, EncodedVersion, f)
 	if err != nil {
 		return pkt.NewErrorMsg(pkt.SERVER_ERROR, err.Error())
 	}
@@ -113,35 +124,35 @@ func (enc *Encoder) Handle(out string) *pkt.ErrorMessage {
 	return nil
 }
 
-func (enc *Encoder) writeDupBlock(f *os.File, b *EncodedBlock) (int64, error) {
+func (enc *Encoder) writeDupBlock(w io.Writer, b *EncodedBlock) (int64, error) {
 	bs1 := []byte{0x01}
 	bs2 := env.IdToBytes(b.OriginalSize)
 	bs3 := env.IdToBytes(b.RealSize)
 	bss := bytes.Join([][]byte{bs1, bs2, bs3, b.VHP, b.KEU, b.VHB}, []byte{})
-	_, err := f.Write(bss)
+	_, err := w.Write(appendCRC(bss))
 	if err != nil {
 		return 0, err
 	}
-	return 1 + 8 + 8 + 32 + 32 + 16, nil
+	return 1 + 8 + 8 + 32 + 32 + 16 + 4, nil
 }
 
-func (enc *Encoder) writeNoDupBlock(f *os.File, b *EncodedBlock) (int64, error) {
+func (enc *Encoder) writeNoDupBlock(w io.Writer, b *EncodedBlock) (int64, error) {
 	bs1 := []byte{0x00}
 	bs2 := env.IdToBytes(b.OriginalSize)
 	bs3 := env.IdToBytes(b.RealSize)
 	size := int64(len(b.DATA))
 	bs4 := env.IdToBytes(size)
-	bss := bytes.Join([][]byte{bs1, bs2, bs3, bs4, b.VHP, b.KEU, b.KED, b.DATA}, []byte{})
-	_, err := f.Write(bss)
+	bs5 := []byte{uint8(len(b.KED))}
+	bss := bytes.Join([][]byte{bs1, bs2, bs3, bs4, bs5, b.VHP, b.KEU, b.KED, b.DATA}, []byte{})
+	_, err := w.Write(appendCRC(bss))
 	if err != nil {
 		return 0, err
 	}
-	return 1 + 8 + 8 + 8 + 32 + 32 + 32 + size, nil
+	return 1 + 8 + 8 + 8 + 1 + 32 + 32 + int64(len(b.KED)) + size + 4, nil
 }
 
-func (enc *Encoder) writeHead(f *os.File) (int64, error) {
+func (enc *Encoder) writeHead(w io.Writer) (int64, error) {
 	bytebuf := bytes.NewBuffer([]byte{})
-	binary.Write(bytebuf, binary.BigEndian, int64(0))
 	binary.Write(bytebuf, binary.BigEndian, enc.fc.GetLength())
 	bytebuf.Write(enc.GetMD5())
 	binary.Write(bytebuf, binary.BigEndian, enc.userId)
@@ -151,20 +162,16 @@ func (enc *Encoder) writeHead(f *os.File) (int64, error) {
 	size := len(bs7)
 	binary.Write(bytebuf, binary.BigEndian, int32(size))
 	bytebuf.Write(bs7)
-	bss := bytebuf.Bytes()
-	_, err := f.Write(bss)
+	bss := appendCRC(bytebuf.Bytes())
+	_, err := w.Write(bss)
 	if err != nil {
 		return 0, err
 	}
-	return 8 + 8 + 16 + 4 + 4 + 4 + 4 + int64(size), nil
-}
-
-func (enc *Encoder) writeKey(f *os.File) error {
-	return WriteKey(enc.key, f)
+	return int64(len(bss)), nil
 }
 
 func (enc *Encoder) writeBottonPos(f *os.File, pos int64) error {
-	_, err := f.Seek(0, io.SeekStart)
+	_, err := f.Seek(prefixSize-8, io.SeekStart)
 	if err != nil {
 		return err
 	}
@@ -178,73 +185,60 @@ func (enc *Encoder) writeBottonPos(f *os.File, pos int64) error {
 
 func Append(s3key, path string) error {
 	key := s3key
-	exist, err := checkExist(key, path)
+	exist, version, err := checkExist(key, path)
 	if err != nil {
 		return err
 	}
 	if !exist {
-		writeAppend(key, path)
+		writeAppend(key, version, path)
 	}
 	return nil
 }
 
-func writeAppend(key, path string) error {
-	f, err := os.OpenFile(path, os.O_APPEND, 0644)
+func writeAppend(key string, version int, path string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
 	if err != nil {
 		return err
 	}
 	defer f.Close()
-	err = WriteKey(key, f)
+	err = writeKey(key, version, f)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func checkExist(key, path string) (bool, error) {
+func checkExist(key, path string) (bool, int, error) {
 	f, err := os.OpenFile(path, os.O_RDONLY, 0644)
 	if err != nil {
-		return false, err
+		return false, 0, err
 	}
 	defer f.Close()
-	pos, err := ReadInt64(f)
+	version, pos, err := readPrefix(f)
 	if err != nil {
-		return false, err
+		return false, 0, err
 	}
-	_, err = f.Seek(pos, io.SeekStart)
+	_, err = f.Seek(keysOffset(version, pos), io.SeekStart)
 	if err != nil {
-		return false, err
+		return false, 0, err
 	}
 	reader := bufio.NewReader(f)
 	for {
-		size, err := ReadInt32(reader)
+		s, _, err := readKey(reader, version)
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			return false, err
-		}
-		bs := make([]byte, size)
-		err = ReadFull(reader, bs)
-		if err != nil {
-			return false, err
+			return false, 0, err
 		}
-		s := string(bs)
 		if s == key {
-			return true, nil
+			return true, version, nil
 		}
 	}
-	return false, nil
+	return false, version, nil
 }
 
+// WriteKey appends an S3 key in the current encoded file format.
 func WriteKey(key string, f *os.File) error {
-	bs := []byte(key)
-	size := int32(len(bs))
-	bs1 := env.Int32ToBytes(size)
-	bss := bytes.Join([][]byte{bs1, bs}, []byte{})
-	_, err := f.Write(bss)
-	if err != nil {
-		return err
-	}
-	return nil
+	return writeKey(key, EncodedVersion, f)
 }
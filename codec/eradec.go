@@ -1,5 +1,11 @@
 package codec
 
+type LRC_Decoder interface {
+	Decode(bs []byte) ([]byte, error)
+	GetOut() []byte
+	Free()
+}
+
 type ErasureDecoder struct {
 	encryptedBlockSize int64
 	decoder            LRC_Decoder
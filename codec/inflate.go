@@ -24,26 +24,31 @@ func NewBlockReader(b *PlainBlock) (*BlockReader, error) {
 	if b == nil {
 		return nil, errors.New("Decode err")
 	}
-	var ret int16 = 0
-	ret <<= 8
-	ret |= int16(b.Data[0] & 0xFF)
-	ret <<= 8
-	ret |= int16(b.Data[1] & 0xFF)
+	_, ret, pos, err := ReadBlockHead(b.Data)
+	if err != nil {
+		return nil, errors.New("Decode err")
+	}
 	r := new(BlockReader)
 	r.block = b
 	r.head = int(ret)
-	var err error = nil
 	if r.head == 0 {
-		r.reader, err = zlib.NewReader(bytes.NewReader(b.Data[2:]))
+		r.reader, err = zlib.NewReader(bytes.NewReader(b.Data[pos:]))
+	} else if r.head == int(HeadNone) {
+		r.reader = bytes.NewReader(b.Data[pos:])
 	} else if r.head < 0 {
-		r.reader = bytes.NewReader(b.Data[2:])
+		c := GetCompressorByHead(ret)
+		if c == nil {
+			logrus.Errorf("[BlockReader]Unknown codec,head:%d\n", r.head)
+			return r, errors.New("Decode err")
+		}
+		r.reader, err = c.NewReader(b.Data[pos:])
 	} else {
 		end := len(b.Data) - r.head
-		if end < 2 {
+		if end < pos {
 			logrus.Errorf("[BlockReader]BlockHead ERR,block size:%d,head:%d\n", len(b.Data), r.head)
 			err = errors.New("Decode err")
 		} else {
-			r.reader, err = zlib.NewReader(bytes.NewReader(b.Data[2:end]))
+			r.reader, err = zlib.NewReader(bytes.NewReader(b.Data[pos:end]))
 		}
 	}
 	return r, err
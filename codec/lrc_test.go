@@ -0,0 +1,130 @@
+//go:build cgo && !purego
+// +build cgo,!purego
+
+package codec
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/yottachain/YTCoreService/env"
+)
+
+// The pure Go LRC is checked against the C library it was ported from.
+
+func initCrossCheck(t *testing.T) *rand.Rand {
+	InitLRC()
+	if !GoLRCInitial(int16(env.LRCInit)) {
+		t.Fatalf("GoLRCInitial(%d) failed", env.LRCInit)
+	}
+	return rand.New(rand.NewSource(20190524))
+}
+
+func randomShards(r *rand.Rand, count int) [][]byte {
+	shards := make([][]byte, count)
+	for ii := range shards {
+		shards[ii] = make([]byte, env.PFL)
+		r.Read(shards[ii])
+		shards[ii][0] = byte(ii)
+	}
+	if r.Intn(2) == 0 {
+		last := shards[count-1]
+		tail := r.Intn(len(last) - 1)
+		for jj := len(last) - tail; jj < len(last); jj++ {
+			last[jj] = 0
+		}
+	}
+	return shards
+}
+
+func TestLRCEncodeCrossCheck(t *testing.T) {
+	r := initCrossCheck(t)
+	counts := []int{1, 2, 3, 4, 5, 9, 10, 16, 17, 63, 64, 65, 100, 127, 128}
+	for ii := 0; ii < 10; ii++ {
+		counts = append(counts, 1+r.Intn(int(env.Max_Shard_Count)))
+	}
+	for _, count := range counts {
+		shards := randomShards(r, count)
+		want, err := LRC_Encode(shards)
+		if err != nil {
+			t.Fatalf("C encode %d shards: %s", count, err)
+		}
+		got, err := GoLRCEncode(shards, int(env.PFL))
+		if err != nil {
+			t.Fatalf("Go encode %d shards: %s", count, err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("%d shards: Go made %d recovery shards, C made %d", count, len(got), len(want))
+		}
+		for jj := range want {
+			if !bytes.Equal(got[jj], want[jj]) {
+				t.Fatalf("%d shards: recovery shard %d differs", count, jj)
+			}
+		}
+	}
+}
+
+func TestLRCDecodeCrossCheck(t *testing.T) {
+	r := initCrossCheck(t)
+	for trial := 0; trial < 60; trial++ {
+		count := 1 + r.Intn(int(env.Max_Shard_Count))
+		shards := randomShards(r, count)
+		parity, err := LRC_Encode(shards)
+		if err != nil {
+			t.Fatalf("C encode %d shards: %s", count, err)
+		}
+		all := append(append([][]byte{}, shards...), parity...)
+		r.Shuffle(len(all), func(i, j int) { all[i], all[j] = all[j], all[i] })
+		lost := r.Intn(len(parity) + 4)
+		if lost > len(all) {
+			lost = len(all)
+		}
+		received := all[lost:]
+
+		size := int64(count)*(env.PFL-1) - int64(r.Intn(int(env.PFL-1)))
+		cdec, err := LRC_Decode(size)
+		if err != nil {
+			t.Fatalf("C begin decode: %s", err)
+		}
+		out := make([]byte, count*int(env.PFL-1))
+		gdec, err := NewGoLRCDecoder(count, int(env.PFL), out)
+		if err != nil {
+			t.Fatalf("Go begin decode: %s", err)
+		}
+		var cout []byte
+		ok := false
+		for ii, shard := range received {
+			cout, err = cdec.Decode(shard)
+			if err != nil {
+				t.Fatalf("trial %d: C decode shard %d: %s", trial, shard[0], err)
+			}
+			ret := gdec.Decode(shard)
+			if ret < 0 {
+				t.Fatalf("trial %d: Go decode shard %d returned %d", trial, shard[0], ret)
+			}
+			if (cout != nil) != (ret > 0) {
+				t.Fatalf("trial %d, %d shards, %d lost: after %d shards C done=%v, Go done=%v",
+					trial, count, lost, ii+1, cout != nil, ret > 0)
+			}
+			if ret > 0 {
+				ok = true
+				break
+			}
+		}
+		cdec.Free()
+		if !ok {
+			continue
+		}
+		if !bytes.Equal(cout, out[:size]) {
+			t.Fatalf("trial %d, %d shards, %d lost: decoded data differs", trial, count, lost)
+		}
+		orig := make([]byte, 0, len(out))
+		for _, s := range shards {
+			orig = append(orig, s[1:]...)
+		}
+		if !bytes.Equal(out[:size], orig[:size]) {
+			t.Fatalf("trial %d, %d shards, %d lost: decoded data is not the original", trial, count, lost)
+		}
+	}
+}
@@ -0,0 +1,710 @@
+package codec
+
+import (
+	"errors"
+	"math"
+	"sync"
+)
+
+// Pure Go port of lrc/YTLRC.c, cm256.c and gf256.c. Recovery shards and
+// decoded data are bit-for-bit the same as the C library produces.
+
+const lrcMaxShards = 256
+
+var (
+	gfOnce     sync.Once
+	gfMulTable [256 * 256]byte
+	gfDivTable [256 * 256]byte
+	gfInvTable [256]byte
+)
+
+// gfInit builds the GF(256) tables over polynomial 0x14d, the default of gf256.c.
+func gfInit() {
+	var exp [512*2 + 1]byte
+	var log [256]uint16
+	const poly = 0xa6<<1 | 1
+	log[0] = 512
+	exp[0] = 1
+	for i := 1; i < 256; i++ {
+		next := uint(exp[i-1]) * 2
+		if next >= 256 {
+			next ^= poly
+		}
+		exp[i] = byte(next)
+		log[exp[i]] = uint16(i)
+	}
+	log[exp[255]] = 255
+	for i := 256; i < 2*255; i++ {
+		exp[i] = exp[i%255]
+	}
+	exp[2*255] = 1
+	for y := 1; y < 256; y++ {
+		logy := log[y]
+		logyn := 255 - logy
+		for x := 1; x < 256; x++ {
+			gfMulTable[y<<8+x] = exp[log[x]+logy]
+			gfDivTable[y<<8+x] = exp[log[x]+logyn]
+		}
+	}
+	for x := 0; x < 256; x++ {
+		gfInvTable[x] = gfDiv(1, byte(x))
+	}
+}
+
+func gfMul(x, y byte) byte {
+	return gfMulTable[int(y)<<8+int(x)]
+}
+
+func gfDiv(x, y byte) byte {
+	return gfDivTable[int(y)<<8+int(x)]
+}
+
+func gfAddMem(z, x []byte) {
+	x = x[:len(z)]
+	for i := range z {
+		z[i] ^= x[i]
+	}
+}
+
+func gfMulMem(z, x []byte, y byte) {
+	t := gfMulTable[int(y)<<8 : int(y)<<8+256]
+	x = x[:len(z)]
+	for i := range z {
+		z[i] = t[x[i]]
+	}
+}
+
+func gfMulAddMem(z []byte, y byte, x []byte) {
+	t := gfMulTable[int(y)<<8 : int(y)<<8+256]
+	x = x[:len(z)]
+	for i := range z {
+		z[i] ^= t[x[i]]
+	}
+}
+
+func gfDivMem(z, x []byte, y byte) {
+	if y != 1 {
+		y = gfInvTable[y]
+	}
+	gfMulMem(z, x, y)
+}
+
+func gfMatrixElement(iMatrix, matrixLen, iElement byte) byte {
+	return gfDiv(iElement^matrixLen, iMatrix^iElement)
+}
+
+var lrcGlobalRecoveryCount = 10
+
+// GoLRCInitial is LRC_Initial of the C library, n counts the global
+// recovery shards plus the local ones of both directions.
+func GoLRCInitial(n int16) bool {
+	if n <= 2 {
+		return false
+	}
+	gfOnce.Do(gfInit)
+	lrcGlobalRecoveryCount = int(n) - 2
+	return true
+}
+
+type lrcParam struct {
+	originalCount       int
+	horLocalCount       int
+	verLocalCount       int
+	totalOriginalCount  int
+	totalRecoveryCount  int
+	globalRecoveryCount int
+	firstHorRecovery    int
+	firstVerRecovery    int
+	firstGlobalRecovery int
+	localOfGlobal       int
+	blockBytes          int
+}
+
+func newLRCParam(originalCount, shardSize int) *lrcParam {
+	p := &lrcParam{originalCount: originalCount, blockBytes: shardSize - 1}
+	p.globalRecoveryCount = lrcGlobalRecoveryCount
+	if originalCount >= 64 {
+		p.horLocalCount = 8
+	} else {
+		p.horLocalCount = int(math.Sqrt(float64(originalCount)))
+	}
+	p.verLocalCount = (originalCount + p.horLocalCount - 1) / p.horLocalCount
+	p.totalOriginalCount = p.horLocalCount * p.verLocalCount
+	p.firstHorRecovery = 0
+	p.firstVerRecovery = p.verLocalCount
+	p.firstGlobalRecovery = p.verLocalCount + p.horLocalCount
+	p.localOfGlobal = p.firstGlobalRecovery + p.globalRecoveryCount
+	p.totalRecoveryCount = p.localOfGlobal + 1
+	return p
+}
+
+func (p *lrcParam) recoveryIndex(i int) int {
+	return p.totalOriginalCount + i
+}
+
+func (p *lrcParam) horRecoveryIndex(i int) int {
+	return p.recoveryIndex(p.firstHorRecovery + i)
+}
+
+func (p *lrcParam) verRecoveryIndex(i int) int {
+	return p.recoveryIndex(p.firstVerRecovery + i)
+}
+
+func (p *lrcParam) globalRecoveryIndex(i int) int {
+	return p.recoveryIndex(p.firstGlobalRecovery + i)
+}
+
+func (p *lrcParam) horDecodeIndex() byte {
+	return byte(p.totalOriginalCount)
+}
+
+func (p *lrcParam) verDecodeIndex() byte {
+	return byte(p.totalOriginalCount + 1)
+}
+
+func (p *lrcParam) globalDecodeIndex(i int) byte {
+	return byte(p.totalOriginalCount + i + 2)
+}
+
+func (p *lrcParam) globalFromHorIndex() int {
+	return p.totalOriginalCount + p.totalRecoveryCount
+}
+
+func (p *lrcParam) globalFromVerIndex() int {
+	return p.totalOriginalCount + p.totalRecoveryCount + 1
+}
+
+type cmBlock struct {
+	data        []byte
+	decodeIndex byte
+	lrcIndex    byte
+}
+
+type cmParams struct {
+	totalOriginalCount int
+	originalCount      int
+	recoveryCount      int
+	firstElement       int
+	step               int
+	blockBytes         int
+}
+
+func cmEncodeBlock(params cmParams, originals []cmBlock, recoveryBlockIndex int, out []byte) {
+	out = out[:params.blockBytes]
+	if params.originalCount == 1 {
+		copy(out, originals[params.firstElement].data)
+		return
+	}
+	if recoveryBlockIndex == params.totalOriginalCount {
+		y := params.firstElement
+		copy(out, originals[y].data)
+		for j := 1; j < params.originalCount; j++ {
+			y += params.step
+			gfAddMem(out, originals[y].data)
+		}
+		return
+	}
+	x0, xi := byte(params.totalOriginalCount), byte(recoveryBlockIndex)
+	y0 := byte(params.firstElement)
+	gfMulMem(out, originals[y0].data, gfMatrixElement(xi, x0, y0))
+	for j := 1; j < params.originalCount; j++ {
+		yj := byte(params.firstElement + j*params.step)
+		gfMulAddMem(out, gfMatrixElement(xi, x0, yj), originals[yj].data)
+	}
+}
+
+// GoLRCEncode is LRC_Encode of the C library. Each original shard leads with
+// its index byte; the recovery shards are returned the same way.
+func GoLRCEncode(data [][]byte, shardSize int) ([][]byte, error) {
+	count := len(data)
+	if count <= 0 || count > 230 || shardSize <= 1 {
+		return nil, errors.New("lrc encode ERR")
+	}
+	p := newLRCParam(count, shardSize)
+	if p.totalRecoveryCount <= 3 || p.totalOriginalCount+p.totalRecoveryCount > lrcMaxShards {
+		return nil, errors.New("lrc encode ERR")
+	}
+	originals := make([]cmBlock, p.totalOriginalCount)
+	for ii := 0; ii < count; ii++ {
+		if len(data[ii]) < shardSize {
+			return nil, errors.New("lrc encode ERR")
+		}
+		originals[ii].data = data[ii][1:shardSize]
+	}
+	zero := make([]byte, p.blockBytes)
+	for ii := count; ii < p.totalOriginalCount; ii++ {
+		originals[ii].data = zero
+	}
+	out := make([]byte, p.totalRecoveryCount*shardSize)
+	shards := make([][]byte, p.totalRecoveryCount)
+	for ii := range shards {
+		shards[ii] = out[ii*shardSize : (ii+1)*shardSize]
+		shards[ii][0] = byte(count + ii)
+	}
+	params := cmParams{
+		totalOriginalCount: p.totalOriginalCount,
+		blockBytes:         p.blockBytes,
+		originalCount:      p.horLocalCount,
+		recoveryCount:      1,
+		step:               1,
+	}
+	for ii := 0; ii < p.verLocalCount; ii++ {
+		params.firstElement = ii * p.horLocalCount
+		cmEncodeBlock(params, originals, p.totalOriginalCount, shards[p.firstHorRecovery+ii][1:])
+	}
+	params.originalCount = p.verLocalCount
+	params.step = p.horLocalCount
+	for ii := 0; ii < p.horLocalCount; ii++ {
+		params.firstElement = ii
+		cmEncodeBlock(params, originals, p.totalOriginalCount+1, shards[p.firstVerRecovery+ii][1:])
+	}
+	local := shards[p.localOfGlobal][1:]
+	params.originalCount = count
+	params.recoveryCount = p.globalRecoveryCount
+	params.firstElement = 0
+	params.step = 1
+	for ii := 0; ii < p.globalRecoveryCount; ii++ {
+		g := shards[p.firstGlobalRecovery+ii][1:]
+		cmEncodeBlock(params, originals, p.totalOriginalCount+ii+2, g)
+		gfAddMem(local, g)
+	}
+	return shards, nil
+}
+
+type cmDecoder struct {
+	params          cmParams
+	recoveryBlock   []*cmBlock
+	originalBlock   []*cmBlock
+	erasuresIndices [lrcMaxShards]byte
+}
+
+func newCMDecoder(params cmParams, blocks []cmBlock) *cmDecoder {
+	d := &cmDecoder{params: params}
+	var original [lrcMaxShards]bool
+	for ii, pos := 0, params.firstElement; ii < params.originalCount; ii, pos = ii+1, pos+params.step {
+		b := &blocks[pos]
+		idx := int(b.lrcIndex)
+		if idx < params.totalOriginalCount {
+			d.originalBlock = append(d.originalBlock, b)
+			if original[idx] {
+				return nil
+			}
+			original[idx] = true
+		} else {
+			d.recoveryBlock = append(d.recoveryBlock, b)
+		}
+	}
+	count := 0
+	for ii := params.firstElement; count < len(d.recoveryBlock) && ii < lrcMaxShards; ii += params.step {
+		if !original[ii] {
+			d.erasuresIndices[count] = byte(ii)
+			count++
+		}
+	}
+	return d
+}
+
+func (d *cmDecoder) decodeM1() {
+	out := d.recoveryBlock[0].data[:d.params.blockBytes]
+	for _, b := range d.originalBlock {
+		gfAddMem(out, b.data)
+	}
+	d.recoveryBlock[0].lrcIndex = d.erasuresIndices[0]
+	d.recoveryBlock[0].decodeIndex = d.erasuresIndices[0]
+}
+
+// ldu is the Schur-type-direct-Cauchy decomposition of cm256.c, laid out the
+// same way: U bottom-up by column, then D, then L top-down by column.
+func (d *cmDecoder) ldu(matrixL, diagD, matrixU []byte) {
+	n := len(d.recoveryBlock)
+	var g, b, rotated [lrcMaxShards]byte
+	for i := 0; i < n; i++ {
+		g[i], b[i] = 1, 1
+	}
+	lastU := (n-1)*n/2 - 1
+	firstOffsetU := 0
+	x0 := byte(d.params.totalOriginalCount)
+	posL := 0
+	for k := 0; k < n-1; k++ {
+		xk := d.recoveryBlock[k].decodeIndex
+		yk := d.erasuresIndices[k]
+		dkk := xk ^ yk
+		lkk := gfDiv(g[k], dkk)
+		ukk := gfMul(gfDiv(b[k], dkk), x0^yk)
+		diagD[k] = gfMul(dkk, gfMul(lkk, ukk))
+		rowL := posL
+		for j := k + 1; j < n; j++ {
+			xj := d.recoveryBlock[j].decodeIndex
+			yj := d.erasuresIndices[j]
+			matrixL[posL] = gfDiv(g[j], xj^yk)
+			posL++
+			rotated[j-k-1] = gfDiv(b[j], xk^yj)
+			g[j] = gfMul(g[j], gfDiv(xj^xk, xj^yk))
+			b[j] = gfMul(b[j], gfDiv(yj^yk, yj^xk))
+		}
+		count := n - (k + 1)
+		gfDivMem(matrixL[rowL:rowL+count], matrixL[rowL:rowL+count], lkk)
+		gfDivMem(rotated[:count], rotated[:count], ukk)
+		out := lastU + firstOffsetU
+		for j := k + 1; j < n; j++ {
+			matrixU[out] = rotated[j-k-1]
+			out -= j
+		}
+		firstOffsetU -= k + 2
+	}
+	rowU := 0
+	for j := n - 1; j > 0; j-- {
+		yj := d.erasuresIndices[j]
+		gfMulMem(matrixU[rowU:rowU+j], matrixU[rowU:rowU+j], x0^yj)
+		rowU += j
+	}
+	xn := d.recoveryBlock[n-1].decodeIndex
+	yn := d.erasuresIndices[n-1]
+	unn := gfMul(b[n-1], x0^yn)
+	diagD[n-1] = gfDiv(gfMul(g[n-1], unn), xn^yn)
+}
+
+func (d *cmDecoder) decode() {
+	n := len(d.recoveryBlock)
+	bytes := d.params.blockBytes
+	toc := byte(d.params.totalOriginalCount)
+	for _, o := range d.originalBlock {
+		for _, r := range d.recoveryBlock {
+			gfMulAddMem(r.data[:bytes], gfMatrixElement(r.decodeIndex, toc, o.lrcIndex), o.data)
+		}
+	}
+	matrix := make([]byte, n*n)
+	sizeU := (n - 1) * n / 2
+	matrixU := matrix[:sizeU]
+	diagD := matrix[sizeU : sizeU+n]
+	matrixL := matrix[sizeU+n:]
+	d.ldu(matrixL, diagD, matrixU)
+	pos := 0
+	for j := 0; j < n-1; j++ {
+		bj := d.recoveryBlock[j].data
+		for i := j + 1; i < n; i++ {
+			gfMulAddMem(d.recoveryBlock[i].data[:bytes], matrixL[pos], bj)
+			pos++
+		}
+	}
+	for i := 0; i < n; i++ {
+		r := d.recoveryBlock[i]
+		r.decodeIndex = d.erasuresIndices[i]
+		r.lrcIndex = d.erasuresIndices[i]
+		gfDivMem(r.data[:bytes], r.data, diagD[i])
+	}
+	pos = 0
+	for j := n - 1; j >= 1; j-- {
+		bj := d.recoveryBlock[j].data
+		for i := j - 1; i >= 0; i-- {
+			gfMulAddMem(d.recoveryBlock[i].data[:bytes], matrixU[pos], bj)
+			pos++
+		}
+	}
+}
+
+func cmDecode(params cmParams, blocks []cmBlock) bool {
+	if params.originalCount <= 0 || params.recoveryCount <= 0 || params.totalOriginalCount < params.originalCount ||
+		params.blockBytes <= 0 || params.firstElement < 0 || params.firstElement > params.totalOriginalCount || params.step <= 0 {
+		return false
+	}
+	if params.totalOriginalCount+params.recoveryCount > lrcMaxShards {
+		return false
+	}
+	if params.originalCount == 1 {
+		blocks[params.firstElement].lrcIndex = byte(params.firstElement)
+		return true
+	}
+	d := newCMDecoder(params, blocks)
+	if d == nil {
+		return false
+	}
+	if len(d.recoveryBlock) == 0 {
+		return true
+	}
+	if params.recoveryCount == 1 && d.recoveryBlock[0].decodeIndex == byte(params.totalOriginalCount) {
+		d.decodeM1()
+		return true
+	}
+	d.decode()
+	return true
+}
+
+// GoLRCDecoder is one decode process of the C library: feed it shards until
+// Decode reports success, the original data is then in the buffer it was
+// created with.
+type GoLRCDecoder struct {
+	param               *lrcParam
+	out                 []byte
+	blocks              [lrcMaxShards + 2]cmBlock
+	horMissed           []int
+	verMissed           []int
+	globalMissed        int
+	numGlobalRecovery   int
+	totalGlobalRecovery int
+	numHorRecovery      int
+	numVerRecovery      int
+	globalRecoveryBuf   []byte
+}
+
+// NewGoLRCDecoder is LRC_BeginDecode. out needs originalCount*(shardSize-1) bytes.
+func NewGoLRCDecoder(originalCount, shardSize int, out []byte) (*GoLRCDecoder, error) {
+	if originalCount <= 0 || originalCount >= lrcMaxShards || shardSize <= 1 {
+		return nil, errors.New("lrc begin decode ERR")
+	}
+	p := newLRCParam(originalCount, shardSize)
+	if len(out) < originalCount*p.blockBytes {
+		return nil, errors.New("lrc begin decode ERR")
+	}
+	d := &GoLRCDecoder{param: p, out: out}
+	zero := make([]byte, p.blockBytes)
+	for j := p.originalCount; j < p.totalOriginalCount; j++ {
+		d.blocks[j] = cmBlock{data: zero, lrcIndex: byte(j), decodeIndex: byte(j)}
+	}
+	d.globalMissed = p.originalCount
+	d.horMissed = make([]int, p.verLocalCount)
+	for j := range d.horMissed {
+		d.horMissed[j] = p.horLocalCount
+	}
+	d.horMissed[p.verLocalCount-1] -= p.totalOriginalCount - p.originalCount
+	d.verMissed = make([]int, p.horLocalCount)
+	for j := range d.verMissed {
+		d.verMissed[j] = p.verLocalCount
+	}
+	for j := p.originalCount; j < p.totalOriginalCount; j++ {
+		d.verMissed[j%p.horLocalCount]--
+	}
+	return d, nil
+}
+
+func (d *GoLRCDecoder) exists(index int) bool {
+	return d.blocks[index].data != nil
+}
+
+func (d *GoLRCDecoder) outBlock(index int) []byte {
+	return d.out[index*d.param.blockBytes : (index+1)*d.param.blockBytes]
+}
+
+func (d *GoLRCDecoder) recovered(x, y int) {
+	if d.horMissed[y] > 0 {
+		d.horMissed[y]--
+	}
+	if d.verMissed[x] > 0 {
+		d.verMissed[x]--
+	}
+	if d.globalMissed > 0 {
+		d.globalMissed--
+	}
+}
+
+func (d *GoLRCDecoder) recoverHor(y int) int {
+	p := d.param
+	rindex := p.horRecoveryIndex(y)
+	if d.horMissed[y] != 1 || !d.exists(rindex) {
+		return -1
+	}
+	index := y * p.horLocalCount
+	for x := 0; x < p.horLocalCount; x, index = x+1, index+1 {
+		if d.exists(index) {
+			continue
+		}
+		b := &d.blocks[index]
+		b.lrcIndex, b.decodeIndex = byte(rindex), p.horDecodeIndex()
+		b.data = d.outBlock(index)
+		copy(b.data, d.blocks[rindex].data[:p.blockBytes])
+		params := cmParams{
+			blockBytes:         p.blockBytes,
+			totalOriginalCount: p.totalOriginalCount,
+			firstElement:       y * p.horLocalCount,
+			originalCount:      p.horLocalCount,
+			recoveryCount:      1,
+			step:               1,
+		}
+		if !cmDecode(params, d.blocks[:]) {
+			b.data = nil
+			return -2
+		}
+		d.recovered(x, y)
+		return x
+	}
+	return -3
+}
+
+func (d *GoLRCDecoder) recoverVer(x int) int {
+	p := d.param
+	rindex := p.verRecoveryIndex(x)
+	if d.verMissed[x] != 1 || !d.exists(rindex) {
+		return -1
+	}
+	index := x
+	for y := 0; y < p.verLocalCount; y, index = y+1, index+p.horLocalCount {
+		if d.exists(index) {
+			continue
+		}
+		b := &d.blocks[index]
+		b.lrcIndex, b.decodeIndex = byte(rindex), p.verDecodeIndex()
+		b.data = d.outBlock(index)
+		copy(b.data, d.blocks[rindex].data[:p.blockBytes])
+		params := cmParams{
+			blockBytes:         p.blockBytes,
+			totalOriginalCount: p.totalOriginalCount,
+			firstElement:       x,
+			originalCount:      p.verLocalCount,
+			recoveryCount:      1,
+			step:               p.horLocalCount,
+		}
+		if !cmDecode(params, d.blocks[:]) {
+			b.data = nil
+			return -2
+		}
+		d.recovered(x, y)
+		return y
+	}
+	return -3
+}
+
+func (d *GoLRCDecoder) recoverGlobal() {
+	p := d.param
+	localIndex := p.recoveryIndex(p.localOfGlobal)
+	if d.numGlobalRecovery == p.globalRecoveryCount-1 && d.exists(localIndex) {
+		if d.globalRecoveryBuf == nil {
+			d.globalRecoveryBuf = make([]byte, p.blockBytes)
+		}
+		buf := d.globalRecoveryBuf
+		copy(buf, d.blocks[localIndex].data[:p.blockBytes])
+		for i := 0; i < p.globalRecoveryCount; i++ {
+			index := p.globalRecoveryIndex(i)
+			if !d.exists(index) {
+				d.blocks[index] = cmBlock{data: buf, lrcIndex: byte(index), decodeIndex: p.globalDecodeIndex(i)}
+			} else {
+				gfAddMem(buf, d.blocks[index].data)
+			}
+		}
+		d.numGlobalRecovery++
+		d.totalGlobalRecovery++
+	}
+	if b := &d.blocks[p.globalFromHorIndex()]; d.numHorRecovery == p.verLocalCount && b.data == nil {
+		buf := make([]byte, p.blockBytes)
+		for i := 0; i < p.verLocalCount; i++ {
+			gfAddMem(buf, d.blocks[p.horRecoveryIndex(i)].data)
+		}
+		*b = cmBlock{data: buf, lrcIndex: byte(p.globalFromHorIndex()), decodeIndex: p.horDecodeIndex()}
+		d.totalGlobalRecovery++
+	}
+	if b := &d.blocks[p.globalFromVerIndex()]; d.numVerRecovery == p.horLocalCount && b.data == nil {
+		buf := make([]byte, p.blockBytes)
+		for i := 0; i < p.horLocalCount; i++ {
+			gfAddMem(buf, d.blocks[p.verRecoveryIndex(i)].data)
+		}
+		*b = cmBlock{data: buf, lrcIndex: byte(p.globalFromVerIndex()), decodeIndex: p.verDecodeIndex()}
+		d.totalGlobalRecovery++
+	}
+}
+
+// Decode is LRC_Decode: 0 if more shards are needed, >0 once the original
+// data is complete, <0 on error.
+func (d *GoLRCDecoder) Decode(shard []byte) int {
+	p := d.param
+	if len(shard) < p.blockBytes+1 {
+		return -1
+	}
+	index := int(shard[0])
+	if index > p.originalCount+p.totalRecoveryCount {
+		return -2
+	}
+	x, y := -1, -1
+	if index < p.originalCount {
+		if d.exists(index) {
+			return 0
+		}
+		b := &d.blocks[index]
+		b.lrcIndex, b.decodeIndex = byte(index), byte(index)
+		b.data = d.outBlock(index)
+		copy(b.data, shard[1:])
+		y, x = index/p.horLocalCount, index%p.horLocalCount
+		d.recovered(x, y)
+	} else {
+		ri := index - p.originalCount
+		index = p.recoveryIndex(ri)
+		if d.exists(index) {
+			return 0
+		}
+		b := &d.blocks[index]
+		b.data = shard[1 : p.blockBytes+1]
+		b.lrcIndex = byte(index)
+		switch {
+		case ri >= p.firstHorRecovery && ri < p.firstHorRecovery+p.verLocalCount:
+			d.numHorRecovery++
+			b.decodeIndex = p.horDecodeIndex()
+			y = ri - p.firstHorRecovery
+		case ri >= p.firstVerRecovery && ri < p.firstVerRecovery+p.horLocalCount:
+			d.numVerRecovery++
+			b.decodeIndex = p.verDecodeIndex()
+			x = ri - p.firstVerRecovery
+		case ri >= p.firstGlobalRecovery && ri < p.firstGlobalRecovery+p.globalRecoveryCount:
+			b.decodeIndex = p.globalDecodeIndex(ri - p.firstGlobalRecovery)
+			d.numGlobalRecovery++
+			d.totalGlobalRecovery++
+		case ri == p.localOfGlobal:
+			b.decodeIndex = p.horDecodeIndex()
+		default:
+			b.data = nil
+			return -3
+		}
+	}
+	for x1, y1 := x, y; y1 >= 0; {
+		if x1 = d.recoverHor(y1); x1 < 0 {
+			break
+		}
+		y1 = d.recoverVer(x1)
+	}
+	for x1, y1 := x, y; x1 >= 0; {
+		if y1 = d.recoverVer(x1); y1 < 0 {
+			break
+		}
+		x1 = d.recoverHor(y1)
+	}
+	d.recoverGlobal()
+	if d.globalMissed <= 0 {
+		return 1
+	}
+	if d.globalMissed > d.totalGlobalRecovery {
+		return 0
+	}
+	localIndex := p.recoveryIndex(p.localOfGlobal)
+	maxIndex := p.globalFromVerIndex()
+	globalIndex := p.globalRecoveryIndex(0)
+	for i := 0; i < p.originalCount; i++ {
+		if d.exists(i) {
+			continue
+		}
+		for !d.exists(globalIndex) {
+			if globalIndex++; globalIndex == localIndex {
+				globalIndex++
+			}
+			if globalIndex > maxIndex {
+				return 0
+			}
+		}
+		g := &d.blocks[globalIndex]
+		b := &d.blocks[i]
+		b.data = d.outBlock(i)
+		copy(b.data, g.data[:p.blockBytes])
+		b.lrcIndex, b.decodeIndex = g.lrcIndex, g.decodeIndex
+		if globalIndex++; globalIndex == localIndex {
+			globalIndex++
+		}
+	}
+	params := cmParams{
+		blockBytes:         p.blockBytes,
+		totalOriginalCount: p.totalOriginalCount,
+		firstElement:       0,
+		originalCount:      p.originalCount,
+		recoveryCount:      d.globalMissed,
+		step:               1,
+	}
+	if cmDecode(params, d.blocks[:]) {
+		return 1
+	}
+	return 0
+}
@@ -1,3 +1,6 @@
+//go:build cgo && !purego
+// +build cgo,!purego
+
 package codec
 
 /*
@@ -75,12 +78,6 @@ func (me *ArrayCreator) Free(p unsafe.Pointer) {
 	C.freeArray(p)
 }
 
-type LRC_Decoder interface {
-	Decode(bs []byte) ([]byte, error)
-	GetOut() []byte
-	Free()
-}
-
 type LRC_Decoder_Linux struct {
 	orgsize int64
 	handle  unsafe.Pointer
@@ -0,0 +1,78 @@
+//go:build !cgo || purego
+// +build !cgo purego
+
+package codec
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/env"
+)
+
+var NoCGO bool = true
+
+func InitLRC() {
+	if !GoLRCInitial(int16(env.LRCInit)) {
+		logrus.Panicf("[LRC]Init ERR,LRCInit:%d\n", env.LRCInit)
+	}
+}
+
+type LRC_Decoder_Go struct {
+	orgsize int64
+	handle  *GoLRCDecoder
+	out     []byte
+	done    bool
+}
+
+func (me *LRC_Decoder_Go) GetOut() []byte {
+	if me.done {
+		return me.out[0:me.orgsize]
+	} else {
+		return nil
+	}
+}
+
+func (me *LRC_Decoder_Go) Decode(bs []byte) ([]byte, error) {
+	if me.done {
+		return me.out[0:me.orgsize], nil
+	}
+	if me.handle == nil {
+		return nil, errors.New("lrc decode ERR")
+	}
+	ret := me.handle.Decode(bs)
+	if ret < 0 {
+		me.Free()
+		return nil, errors.New("lrc decode ERR")
+	}
+	if ret > 0 {
+		me.Free()
+		me.done = true
+		return me.out[0:me.orgsize], nil
+	} else {
+		return nil, nil
+	}
+}
+
+func (me *LRC_Decoder_Go) Free() {
+	me.handle = nil
+}
+
+func LRC_Decode(originalCount int64) (LRC_Decoder, error) {
+	shardsize := int64(env.PFL - 1)
+	shardCount := originalCount / shardsize
+	remainSize := originalCount % shardsize
+	if remainSize > 0 {
+		shardCount++
+	}
+	o := make([]byte, shardsize*shardCount)
+	handle, err := NewGoLRCDecoder(int(shardCount), int(env.PFL), o)
+	if err != nil {
+		return nil, err
+	}
+	return &LRC_Decoder_Go{orgsize: originalCount, handle: handle, out: o}, nil
+}
+
+func LRC_Encode(data [][]byte) ([][]byte, error) {
+	return GoLRCEncode(data, int(env.PFL))
+}
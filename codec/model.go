@@ -1,6 +1,7 @@
 package codec
 
 import (
+	"bytes"
 	"crypto/md5"
 	"crypto/sha256"
 	"encoding/json"
@@ -106,6 +107,53 @@ func InitPlainBlock(jsonstr string) (*PlainBlock, error) {
 	return nil, err
 }
 
+// A plain block starts with the two-byte head telling how it was packed.
+// Blocks in a format newer than CBC put a format byte before the head,
+// blockHeadMark or'ed with the format. The high bit is clear in legacy
+// zlib heads and the byte is 0xFF in legacy negative heads, so the two
+// layouts do not overlap.
+const blockHeadMark = 0x80
+
+// BlockHeadSize returns the size of the header a block in format takes.
+func BlockHeadSize(format uint8) int {
+	if format == BlockFormatCBC {
+		return 2
+	}
+	return 3
+}
+
+func writeBlockHead(buf *bytes.Buffer, format uint8, head int16) {
+	if format != BlockFormatCBC {
+		buf.WriteByte(blockHeadMark | format)
+	}
+	buf.Write([]byte{uint8(head >> 8), uint8(head)})
+}
+
+// ReadBlockHead returns the format a plain block declares, its head and
+// the size of its header.
+func ReadBlockHead(data []byte) (uint8, int16, int, error) {
+	format := BlockFormatCBC
+	pos := 0
+	if len(data) > 0 && data[0]&blockHeadMark != 0 && data[0] != 0xFF {
+		format = data[0] &^ blockHeadMark
+		pos = 1
+	}
+	if len(data) < pos+2 {
+		return 0, 0, 0, errors.New("block head err")
+	}
+	head := int16(data[pos])<<8 | int16(data[pos+1])
+	return format, head, pos + 2, nil
+}
+
+// Format returns the format the block declares in its header.
+func (pb *PlainBlock) Format() uint8 {
+	format, _, _, err := ReadBlockHead(pb.Data)
+	if err != nil {
+		return BlockFormatCBC
+	}
+	return format
+}
+
 func (pb *PlainBlock) Sum() error {
 	if pb.Data == nil {
 		return errors.New("plainBlock sum:data is null")
@@ -127,15 +175,7 @@ func (pb *PlainBlock) Sum() error {
 }
 
 func (pb *PlainBlock) InMemory() bool {
-	var encryptedBlockSize int
-	bsize := len(pb.Data)
-	remain := bsize % 16
-	if remain == 0 {
-		encryptedBlockSize = bsize + 16
-	} else {
-		encryptedBlockSize = bsize + (16 - remain)
-	}
-	return encryptedBlockSize < env.PL2
+	return pb.GetEncryptedBlockSize() < int64(env.PL2)
 }
 
 func (pb *PlainBlock) ToJson() string {
@@ -146,19 +186,22 @@ func (pb *PlainBlock) ToJson() string {
 	return string(b)
 }
 
+// GetEncryptedBlockSize returns the size of the block once encrypted in
+// the format its header declares.
 func (pb *PlainBlock) GetEncryptedBlockSize() int64 {
 	if pb.Data == nil {
 		return 0
 	}
-	size := len(pb.Data)
-	remain := size % 16
-	if remain == 0 {
-		return int64(size + 16)
-	} else {
-		return int64(size + (16 - remain))
+	size := GetEncryptedBlockSize(int64(len(pb.Data)))
+	if pb.Format() == BlockFormatGCM {
+		size = size + gcmHeaderSize + 16
 	}
+	return size
 }
 
+// GetEncryptedBlockSize returns the encrypted size of a block from the
+// RealSize of its refer. GCM blocks report their encrypted size less 16 as
+// RealSize, so the result holds for either format.
 func GetEncryptedBlockSize(orgsize int64) int64 {
 	remain := orgsize % 16
 	if remain == 0 {
@@ -172,6 +215,54 @@ type EncryptedBlock struct {
 	Block
 	VHB       []byte
 	SecretKey []byte
+	RealSize  int64
+	Format    uint8
+	Nonce     []byte
+}
+
+// MakeKED wraps the block key with the KD of the plain block. The KED of a
+// GCM block wraps its nonce as well, so that CheckBlockDup can encrypt a
+// duplicate again into the same VHB; its length tells the formats apart.
+func (eb *EncryptedBlock) MakeKED(kd []byte) []byte {
+	if eb.Format == BlockFormatGCM {
+		return ECBEncryptNoPad(bytes.Join([][]byte{eb.SecretKey, eb.Nonce}, []byte{}), kd)
+	}
+	return ECBEncryptNoPad(eb.SecretKey, kd)
+}
+
+const KEDSize = 32
+const gcmKEDSize = 48
+
+// OpenKED returns the block key and, for a GCM block, the nonce wrapped in
+// ked.
+func OpenKED(ked, kd []byte) ([]byte, []byte) {
+	bs := ECBDecryptNoPad(ked, kd)
+	if len(ked) == gcmKEDSize {
+		return bs[0:32], bs[32 : 32+gcmNonceSize]
+	}
+	return bs, nil
+}
+
+// ReferFormat returns the format the SN records in the refer of the block
+// whose KED is ked. CBC is left out, so that those refers keep the layout
+// older readers parse.
+func ReferFormat(ked []byte) uint8 {
+	if KEDBlockFormat(ked) == BlockFormatGCM {
+		return BlockFormatGCM
+	}
+	return 0
+}
+
+// KEDBlockFormat returns the format of the block ked was made for, 0 if
+// ked is invalid.
+func KEDBlockFormat(ked []byte) uint8 {
+	switch len(ked) {
+	case KEDSize:
+		return BlockFormatCBC
+	case gcmKEDSize:
+		return BlockFormatGCM
+	}
+	return 0
 }
 
 func (eb *EncryptedBlock) MakeVHB() error {
@@ -1,6 +1,10 @@
 package codec
 
 import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash/crc32"
 	"io"
 	"path/filepath"
 
@@ -83,3 +87,99 @@ func ReadFull(r io.Reader, bs []byte) error {
 func SimpleName(name string) string {
 	return filepath.Base(name)
 }
+
+// An encoded file starts with a magic, the format version and the offset of
+// its trailer. The header and each block record are followed by a CRC32,
+// the trailer holds a SHA256 of everything between the prefix and itself,
+// and the S3 keys appended after the trailer carry a CRC32 each. Files
+// without the magic are in the legacy layout, starting with the offset of
+// the keys. Since version 2 a non-duplicate block record gives the length
+// of its KED, which is longer for GCM blocks.
+const (
+	EncodedVersion = 2
+	encodedMagic   = "YTEF"
+	trailerMagic   = "YTET"
+	prefixSize     = 4 + 1 + 8
+	trailerSize    = 4 + sha256.Size
+)
+
+// readPrefix returns the format version of an encoded file, 0 for legacy,
+// and the offset stored in its prefix.
+func readPrefix(r io.Reader) (int, int64, error) {
+	bs := make([]byte, 8)
+	if err := ReadFull(r, bs); err != nil {
+		return 0, 0, err
+	}
+	if string(bs[0:4]) != encodedMagic {
+		return 0, env.BytesToId(bs), nil
+	}
+	bs = append(bs, make([]byte, prefixSize-8)...)
+	if err := ReadFull(r, bs[8:]); err != nil {
+		return 0, 0, err
+	}
+	if bs[4] == 0 || bs[4] > EncodedVersion {
+		return 0, 0, fmt.Errorf("unsupported encoded file version %d", bs[4])
+	}
+	return int(bs[4]), env.BytesToId(bs[5:]), nil
+}
+
+func writePrefix(w io.Writer) error {
+	bs := append([]byte(encodedMagic), EncodedVersion)
+	_, err := w.Write(append(bs, env.IdToBytes(0)...))
+	return err
+}
+
+func keysOffset(version int, pos int64) int64 {
+	if version > 0 {
+		return pos + trailerSize
+	}
+	return pos
+}
+
+func appendCRC(bs []byte) []byte {
+	return append(bs, env.Int32ToBytes(int32(crc32.ChecksumIEEE(bs)))...)
+}
+
+func readCRC(r io.Reader, sum uint32) error {
+	i, err := ReadInt32(r)
+	if err != nil {
+		return err
+	}
+	if uint32(i) != sum {
+		return errors.New("crc mismatch")
+	}
+	return nil
+}
+
+func writeKey(key string, version int, w io.Writer) error {
+	bs := append(env.Int32ToBytes(int32(len(key))), key...)
+	if version > 0 {
+		bs = appendCRC(bs)
+	}
+	_, err := w.Write(bs)
+	return err
+}
+
+// readKey returns the next S3 key and the bytes it took, io.EOF after the
+// last one.
+func readKey(r io.Reader, version int) (string, int64, error) {
+	size, err := ReadInt32(r)
+	if err != nil {
+		return "", 0, err
+	}
+	if size < 0 {
+		return "", 0, errors.New("invalid key length")
+	}
+	bs := make([]byte, size)
+	if err = ReadFull(r, bs); err != nil {
+		return "", 0, err
+	}
+	n := 4 + int64(size)
+	if version > 0 {
+		if err = readCRC(r, crc32.ChecksumIEEE(append(env.Int32ToBytes(size), bs...))); err != nil {
+			return "", 0, err
+		}
+		n = n + 4
+	}
+	return string(bs), n, nil
+}
@@ -0,0 +1,263 @@
+package codec
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yottachain/YTCoreService/env"
+	"github.com/yottachain/YTCoreService/pkt"
+)
+
+const (
+	testUserId      = 7
+	testKeyNumber   = 2
+	testStoreNumber = 3
+	testSign        = "signature"
+)
+
+// testChecker stores every second block as a duplicate.
+type testChecker struct {
+	kedSize int
+	blocks  []*EncodedBlock
+}
+
+func (c *testChecker) Check(b *PlainBlock, id int) (*EncodedBlock, *pkt.ErrorMessage) {
+	fill := func(n int, v byte) []byte { return bytes.Repeat([]byte{v}, n) }
+	eb := &EncodedBlock{OriginalSize: b.OriginalSize, RealSize: int64(len(b.Data)), VHP: fill(32, byte(id)), KEU: fill(32, 0xEE)}
+	if id%2 == 0 {
+		eb.IsDup = true
+		eb.VHB = fill(16, 0xBB)
+	} else {
+		eb.KED = fill(c.kedSize, 0xDD)
+		eb.DATA = b.Data[0:100]
+	}
+	c.blocks = append(c.blocks, eb)
+	return eb, nil
+}
+
+// testEncodedFile lays out an encoded file of the given version without the
+// Encoder, so the format itself is pinned down.
+func testEncodedFile(version int, length int64, md5sum []byte, blocks []*EncodedBlock, keys []string) []byte {
+	be := func(i int64, n int) []byte {
+		bs := env.IdToBytes(i)
+		return bs[8-n:]
+	}
+	buf := bytes.NewBuffer(nil)
+	record := func(bs []byte) {
+		if version > 0 {
+			bs = appendCRC(bs)
+		}
+		buf.Write(bs)
+	}
+	if version > 0 {
+		buf.WriteString(encodedMagic)
+		buf.WriteByte(byte(version))
+	}
+	buf.Write(make([]byte, 8))
+	record(bytes.Join([][]byte{be(length, 8), md5sum, be(testUserId, 4), be(testKeyNumber, 4), be(testStoreNumber, 4),
+		be(int64(len(testSign)), 4), []byte(testSign)}, nil))
+	for _, b := range blocks {
+		if b.IsDup {
+			record(bytes.Join([][]byte{{0x01}, be(b.OriginalSize, 8), be(b.RealSize, 8), b.VHP, b.KEU, b.VHB}, nil))
+			continue
+		}
+		kedsize := []byte{byte(len(b.KED))}
+		if version < 2 {
+			kedsize = nil
+		}
+		record(bytes.Join([][]byte{{0x00}, be(b.OriginalSize, 8), be(b.RealSize, 8), be(int64(len(b.DATA)), 8), kedsize,
+			b.VHP, b.KEU, b.KED, b.DATA}, nil))
+	}
+	pos := int64(buf.Len())
+	if version > 0 {
+		digest := sha256.Sum256(buf.Bytes()[prefixSize:])
+		buf.WriteString(trailerMagic)
+		buf.Write(digest[:])
+	}
+	for _, key := range keys {
+		record(append(be(int64(len(key)), 4), key...))
+	}
+	bs := buf.Bytes()
+	if version > 0 {
+		copy(bs[prefixSize-8:], env.IdToBytes(pos))
+	} else {
+		copy(bs, env.IdToBytes(pos))
+	}
+	return bs
+}
+
+func testData(size int) []byte {
+	data := make([]byte, size)
+	rand.New(rand.NewSource(1)).Read(data)
+	return data
+}
+
+func testEncode(t *testing.T, dir string, data []byte, kedSize int) (string, *testChecker) {
+	fc, err := NewBytesEncoder(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checker := &testChecker{kedSize: kedSize}
+	enc := NewEncoder(testUserId, testKeyNumber, testStoreNumber, testSign, "bucket/key", fc, checker)
+	path := filepath.Join(dir, enc.GetBaseSHA256())
+	if errmsg := enc.Handle(path); errmsg != nil {
+		t.Fatal(pkt.ToError(errmsg))
+	}
+	return path, checker
+}
+
+func testDecode(t *testing.T, path string, blocks []*EncodedBlock, keys []string) {
+	dec, err := NewDecoder(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dec.Close()
+	if dec.UserId != testUserId || dec.KeyNumber != testKeyNumber || dec.StoreNumber != testStoreNumber || dec.Sign != testSign {
+		t.Fatalf("header fields %d %d %d %q", dec.UserId, dec.KeyNumber, dec.StoreNumber, dec.Sign)
+	}
+	for i, want := range blocks {
+		b, err := dec.ReadNext()
+		if err != nil {
+			t.Fatalf("block %d: %s", i, err)
+		}
+		if b == nil {
+			t.Fatalf("block %d missing", i)
+		}
+		if b.IsDup != want.IsDup || b.OriginalSize != want.OriginalSize || b.RealSize != want.RealSize ||
+			!bytes.Equal(b.VHP, want.VHP) || !bytes.Equal(b.KEU, want.KEU) || !bytes.Equal(b.VHB, want.VHB) ||
+			!bytes.Equal(b.KED, want.KED) || !bytes.Equal(b.DATA, want.DATA) {
+			t.Fatalf("block %d changed", i)
+		}
+	}
+	if b, err := dec.ReadNext(); b != nil || err != nil {
+		t.Fatalf("extra block %v %v", b, err)
+	}
+	for _, want := range keys {
+		key, err := dec.ReadNextKey()
+		if err != nil || key != want {
+			t.Fatalf("key %q, expected %q: %v", key, want, err)
+		}
+	}
+	if key, err := dec.ReadNextKey(); key != "" || err != nil {
+		t.Fatalf("extra key %q %v", key, err)
+	}
+}
+
+func TestEncodedFileRoundTrip(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encoded")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	data := testData(int(env.Default_Block_Size)*2 + 1000)
+	sum := md5.Sum(data)
+	for _, kedSize := range []int{KEDSize, gcmKEDSize} {
+		path, checker := testEncode(t, dir, data, kedSize)
+		if len(checker.blocks) != 3 {
+			t.Fatalf("%d blocks", len(checker.blocks))
+		}
+		bs, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := testEncodedFile(EncodedVersion, int64(len(data)), sum[:], checker.blocks, []string{"bucket/key"})
+		if !bytes.Equal(bs, want) {
+			t.Fatalf("KED %d: encoded file differs from the format", kedSize)
+		}
+		if err := Append("bucket/other", path); err != nil {
+			t.Fatal(err)
+		}
+		if err := Append("bucket/key", path); err != nil {
+			t.Fatal(err)
+		}
+		testDecode(t, path, checker.blocks, []string{"bucket/key", "bucket/other"})
+	}
+}
+
+func TestEncodedFileVersions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encoded")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fill := func(n int, v byte) []byte { return bytes.Repeat([]byte{v}, n) }
+	blocks := []*EncodedBlock{
+		{OriginalSize: 1000, RealSize: 900, VHP: fill(32, 1), KEU: fill(32, 2), KED: fill(KEDSize, 3), DATA: fill(912, 4)},
+		{OriginalSize: 2000, RealSize: 1900, VHP: fill(32, 5), KEU: fill(32, 6), VHB: fill(16, 7), IsDup: true},
+	}
+	keys := []string{"bucket/a", "bucket/b"}
+	for version := 0; version <= EncodedVersion; version++ {
+		path := filepath.Join(dir, fmt.Sprintf("v%d", version))
+		bs := testEncodedFile(version, 3000, fill(16, 9), blocks, keys)
+		if err := ioutil.WriteFile(path, bs, 0644); err != nil {
+			t.Fatal(err)
+		}
+		testDecode(t, path, blocks, keys)
+		if err := Append("bucket/c", path); err != nil {
+			t.Fatal(err)
+		}
+		testDecode(t, path, blocks, append(keys, "bucket/c"))
+	}
+}
+
+func TestEncodedFileCorrupted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "encoded")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	fill := func(n int, v byte) []byte { return bytes.Repeat([]byte{v}, n) }
+	blocks := []*EncodedBlock{
+		{OriginalSize: 1000, RealSize: 900, VHP: fill(32, 1), KEU: fill(32, 2), KED: fill(gcmKEDSize, 3), DATA: fill(912, 4)},
+	}
+	bs := testEncodedFile(EncodedVersion, 1000, fill(16, 9), blocks, []string{"bucket/a"})
+	headEnd := prefixSize + 8 + 16 + 4*4 + len(testSign) + 4
+	trailer := len(bs) - (4 + len("bucket/a") + 4) - trailerSize
+	tests := []struct {
+		name   string
+		change func([]byte) []byte
+		open   bool
+	}{
+		{"version", func(bs []byte) []byte { bs[4] = EncodedVersion + 1; return bs }, false},
+		{"header", func(bs []byte) []byte { bs[prefixSize+3] ^= 1; return bs }, false},
+		{"header crc", func(bs []byte) []byte { bs[headEnd-1] ^= 1; return bs }, false},
+		{"block", func(bs []byte) []byte { bs[headEnd+200] ^= 1; return bs }, false},
+		{"trailer magic", func(bs []byte) []byte { bs[trailer] ^= 1; return bs }, false},
+		{"trailer digest", func(bs []byte) []byte { bs[trailer+10] ^= 1; return bs }, false},
+		{"truncated", func(bs []byte) []byte { return bs[0:trailer] }, false},
+		{"key", func(bs []byte) []byte { bs[len(bs)-6] ^= 1; return bs }, true},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(dir, "corrupted")
+		if err := ioutil.WriteFile(path, tt.change(append([]byte{}, bs...)), 0644); err != nil {
+			t.Fatal(err)
+		}
+		dec, err := NewDecoder(path)
+		if !tt.open {
+			if err == nil {
+				dec.Close()
+				t.Errorf("%s: corrupted file opened", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %s", tt.name, err)
+		}
+		for dec.HasNextBlock() {
+			if _, err := dec.ReadNext(); err != nil {
+				t.Fatalf("%s: %s", tt.name, err)
+			}
+		}
+		if _, err := dec.ReadNextKey(); err == nil {
+			t.Errorf("%s: corrupted key read", tt.name)
+		}
+		dec.Close()
+	}
+}
@@ -0,0 +1,106 @@
+package codec
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/yottachain/YTCoreService/env"
+)
+
+// NewStreamEncoder encodes a reader of known size in one pass. VHW and MD5
+// are only available once the encoder is finished.
+func NewStreamEncoder(r io.Reader, size int64) (*FileEncoder, error) {
+	if size <= 0 {
+		return nil, errors.New("zero length file")
+	}
+	e := new(FileEncoder)
+	e.length = size
+	e.reader = NewStreamReader(r)
+	return e, nil
+}
+
+func (fileEncoder *FileEncoder) IsStream() bool {
+	_, ok := fileEncoder.reader.(*StreamReader)
+	return ok
+}
+
+func (fileEncoder *FileEncoder) streamEnd() error {
+	sr, ok := fileEncoder.reader.(*StreamReader)
+	if !ok || fileEncoder.vhw != nil {
+		return nil
+	}
+	if sr.total != fileEncoder.length {
+		return fmt.Errorf("stream length %d,expected %d", sr.total, fileEncoder.length)
+	}
+	fileEncoder.vhw = sr.sha256.Sum(nil)
+	fileEncoder.md5 = sr.md5.Sum(nil)
+	return nil
+}
+
+// StreamReader hashes everything read from r and keeps the bytes read since
+// the last Mark, so the encoder can seek back within the current block.
+type StreamReader struct {
+	r      io.Reader
+	sha256 hash.Hash
+	md5    hash.Hash
+	buf    []byte
+	pos    int
+	total  int64
+	eof    bool
+}
+
+func NewStreamReader(r io.Reader) *StreamReader {
+	return &StreamReader{r: r, sha256: sha256.New(), md5: md5.New()}
+}
+
+func (sr *StreamReader) Mark() {
+	sr.buf = append(sr.buf[:0], sr.buf[sr.pos:]...)
+	sr.pos = 0
+}
+
+func (sr *StreamReader) fill() error {
+	if sr.eof {
+		return io.EOF
+	}
+	n := len(sr.buf)
+	sr.buf = append(sr.buf, make([]byte, env.READFILE_BUF_SIZE)...)
+	num, err := sr.r.Read(sr.buf[n:])
+	sr.buf = sr.buf[:n+num]
+	if num > 0 {
+		bs := sr.buf[n:]
+		sr.sha256.Write(bs)
+		sr.md5.Write(bs)
+		sr.total = sr.total + int64(num)
+	}
+	if err == io.EOF {
+		sr.eof = true
+		if num > 0 {
+			return nil
+		}
+	}
+	return err
+}
+
+func (sr *StreamReader) Read(p []byte) (int, error) {
+	for sr.pos == len(sr.buf) {
+		err := sr.fill()
+		if err != nil {
+			return 0, err
+		}
+	}
+	num := copy(p, sr.buf[sr.pos:])
+	sr.pos = sr.pos + num
+	return num, nil
+}
+
+func (sr *StreamReader) Seek(offset int64, whence int) (int64, error) {
+	if whence != io.SeekCurrent || offset > int64(len(sr.buf)-sr.pos) || -offset > int64(sr.pos) {
+		return 0, errors.New("not Supported")
+	}
+	sr.pos = sr.pos + int(offset)
+	return offset, nil
+}
@@ -19,6 +19,7 @@ type BlockMeta struct {
 	VNF   int16  `bson:"VNF"`
 	NLINK int32  `bson:"NLINK"`
 	AR    int16  `bson:"AR"`
+	RS    int32  `bson:"RS,omitempty"`
 }
 
 func GetBlockByVHP(vhp []byte) ([]*BlockMeta, error) {
@@ -27,7 +28,7 @@ func GetBlockByVHP(vhp []byte) ([]*BlockMeta, error) {
 	filter := bson.M{"VHP": vhp}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	opt := options.Find().SetProjection(bson.M{"VHB": 1, "KED": 1, "AR": 1})
+	opt := options.Find().SetProjection(bson.M{"VHB": 1, "KED": 1, "AR": 1, "RS": 1})
 	cur, err := source.GetBlockColl().Find(ctx, filter, opt)
 	defer func() {
 		if cur != nil {
@@ -102,6 +102,38 @@ func ListBucket(uid int32) ([]string, error) {
 	return result, nil
 }
 
+func ListBucketMeta(uid int32) ([]*BucketMeta, error) {
+	source := NewUserMetaSource(uint32(uid))
+	var result = []*BucketMeta{}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	cur, err := source.GetBucketColl().Find(ctx, bson.M{})
+	defer func() {
+		if cur != nil {
+			cur.Close(ctx)
+		}
+	}()
+	if err != nil {
+		logrus.Errorf("[BucketMeta]ListBucketMeta ERR:%s\n", err)
+		return nil, err
+	}
+	for cur.Next(ctx) {
+		var res = &BucketMeta{}
+		err = cur.Decode(res)
+		if err != nil {
+			logrus.Errorf("[BucketMeta]ListBucketMeta Decode ERR:%s\n", err)
+			return nil, err
+		}
+		res.UserId = uid
+		result = append(result, res)
+	}
+	if err := cur.Err(); err != nil {
+		logrus.Errorf("[BucketMeta]ListBucketMeta Cursor ERR:%s\n", err)
+		return nil, err
+	}
+	return result, nil
+}
+
 func GetBucketByName(bname string, uid int32) (*BucketMeta, error) {
 	source := NewUserMetaSource(uint32(uid))
 	filter := bson.M{"bucketName": bname}
@@ -159,7 +191,7 @@ func UpdateBucketMeta(meta *BucketMeta) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	filter := bson.M{"_id": meta.BucketId}
-	update := bson.M{"$set": bson.M{"Meta": meta.Meta}}
+	update := bson.M{"$set": bson.M{"meta": meta.Meta}}
 	_, err := source.GetBucketColl().UpdateOne(ctx, filter, update)
 	if err != nil {
 		logrus.Errorf("[BucketMeta]UpdateBucketMeta UserID:%d,Name:%s,ERR:%s\n", meta.UserId, meta.BucketName, err)
@@ -170,6 +202,50 @@ func UpdateBucketMeta(meta *BucketMeta) error {
 	return nil
 }
 
+const Max_Bucket_Meta_Retries = 10
+
+var ErrBucketMetaConflict = errors.New("bucket meta changed concurrently")
+
+// ModifyBucketMeta stores modify(meta) as the new bucket meta. The update
+// only applies while the stored meta is still the one modify was given, and
+// is redone on the latest meta otherwise, so concurrent writers of
+// different keys never lose each other's changes.
+func ModifyBucketMeta(meta *BucketMeta, modify func([]byte) ([]byte, error)) error {
+	source := NewUserMetaSource(uint32(meta.UserId))
+	key := fmt.Sprintf("%d-%s", meta.UserId, meta.BucketName)
+	old := meta.Meta
+	for ii := 0; ii < Max_Bucket_Meta_Retries; ii++ {
+		bs, err := modify(old)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		filter := bson.M{"_id": meta.BucketId, "meta": old}
+		update := bson.M{"$set": bson.M{"meta": bs}}
+		res, err := source.GetBucketColl().UpdateOne(ctx, filter, update)
+		cancel()
+		if err != nil {
+			logrus.Errorf("[BucketMeta]ModifyBucketMeta UserID:%d,Name:%s,ERR:%s\n", meta.UserId, meta.BucketName, err)
+			return err
+		}
+		if res.MatchedCount > 0 {
+			BUCKET_CACHE.SetDefault(key, &BucketMeta{BucketId: meta.BucketId, BucketName: meta.BucketName, Meta: bs, UserId: meta.UserId})
+			return nil
+		}
+		cur, err := GetBucketByName(meta.BucketName, meta.UserId)
+		if err != nil {
+			return err
+		}
+		if cur == nil || cur.BucketId != meta.BucketId {
+			BUCKET_CACHE.Delete(key)
+			return errors.New("INVALID_BUCKET_NAME")
+		}
+		old = cur.Meta
+	}
+	logrus.Errorf("[BucketMeta]ModifyBucketMeta UserID:%d,Name:%s,ERR:%s\n", meta.UserId, meta.BucketName, ErrBucketMetaConflict)
+	return ErrBucketMetaConflict
+}
+
 func SaveBucketMeta(meta *BucketMeta) error {
 	source := NewUserMetaSource(uint32(meta.UserId))
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -204,3 +280,40 @@ func BucketIsEmpty(uid uint32, id primitive.ObjectID) (bool, error) {
 	}
 	return false, nil
 }
+
+// SumBucketFiles returns the number of file versions in a bucket and the sum
+// of size(meta) over them.
+func SumBucketFiles(uid uint32, id primitive.ObjectID, size func([]byte) int64) (int64, int64, error) {
+	source := NewUserMetaSource(uid)
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	opt := options.Find().SetProjection(bson.M{"version.meta": 1})
+	cur, err := source.GetFileColl().Find(ctx, bson.M{"bucketId": id}, opt)
+	defer func() {
+		if cur != nil {
+			cur.Close(ctx)
+		}
+	}()
+	if err != nil {
+		logrus.Errorf("[BucketMeta]SumBucketFiles ERR:%s\n", err)
+		return 0, 0, err
+	}
+	var count, total int64
+	for cur.Next(ctx) {
+		res := &FileMetaWithVersion{}
+		err = cur.Decode(res)
+		if err != nil {
+			logrus.Errorf("[BucketMeta]SumBucketFiles Decode ERR:%s\n", err)
+			return 0, 0, err
+		}
+		for _, ver := range res.Version {
+			count++
+			total = total + size(ver.Meta)
+		}
+	}
+	if err := cur.Err(); err != nil {
+		logrus.Errorf("[BucketMeta]SumBucketFiles Cursor ERR:%s\n", err)
+		return 0, 0, err
+	}
+	return count, total, nil
+}
@@ -242,3 +242,84 @@ func GetUserSumTime(userid int32) (int64, error) {
 	}
 	return result.StatTime, err
 }
+
+// EventLOG is an event waiting in the outbox for delivery to a webhook.
+type EventLOG struct {
+	Id       primitive.ObjectID `bson:"_id"`
+	UID      int32              `bson:"UID"`
+	Url      string             `bson:"url"`
+	Body     []byte             `bson:"body"`
+	Attempts int                `bson:"attempts"`
+	NextTime int64              `bson:"nextTime"`
+}
+
+func AddEventLOG(uid int32, url string, body []byte) error {
+	event := &EventLOG{Id: primitive.NewObjectID(), UID: uid, Url: url, Body: body, NextTime: time.Now().Unix()}
+	source := NewCacheBaseSource()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := source.GetEventColl().InsertOne(ctx, event)
+	if err != nil {
+		logrus.Errorf("[CacheMeta]AddEventLOG UserID:%d,ERR:%s\n", uid, err)
+		return err
+	}
+	return nil
+}
+
+// CountEventLOG counts the events a user has queued, stopping at limit.
+func CountEventLOG(uid int32, limit int64) (int64, error) {
+	source := NewCacheBaseSource()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	num, err := source.GetEventColl().CountDocuments(ctx, bson.M{"UID": uid}, options.Count().SetLimit(limit))
+	if err != nil {
+		logrus.Errorf("[CacheMeta]CountEventLOG UserID:%d,ERR:%s\n", uid, err)
+		return 0, err
+	}
+	return num, nil
+}
+
+// ClaimEventLOG takes the oldest due event and hides it from other workers
+// for lease, after which it is delivered again unless deleted.
+func ClaimEventLOG(now time.Time, lease time.Duration) *EventLOG {
+	source := NewCacheBaseSource()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	filter := bson.M{"nextTime": bson.M{"$lte": now.Unix()}}
+	update := bson.M{"$set": bson.M{"nextTime": now.Add(lease).Unix()}}
+	opt := options.FindOneAndUpdate().SetSort(bson.M{"nextTime": 1})
+	event := &EventLOG{}
+	err := source.GetEventColl().FindOneAndUpdate(ctx, filter, update, opt).Decode(event)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logrus.Errorf("[CacheMeta]ClaimEventLOG ERR:%s\n", err)
+		}
+		return nil
+	}
+	return event
+}
+
+func DeleteEventLOG(id primitive.ObjectID) error {
+	source := NewCacheBaseSource()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := source.GetEventColl().DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		logrus.Errorf("[CacheMeta]DeleteEventLOG ERR:%s\n", err)
+		return err
+	}
+	return nil
+}
+
+func RetryEventLOG(id primitive.ObjectID, attempts int, next time.Time) error {
+	source := NewCacheBaseSource()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	update := bson.M{"$set": bson.M{"attempts": attempts, "nextTime": next.Unix()}}
+	_, err := source.GetEventColl().UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		logrus.Errorf("[CacheMeta]RetryEventLOG ERR:%s\n", err)
+		return err
+	}
+	return nil
+}
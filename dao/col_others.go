@@ -62,6 +62,7 @@ const DNI_CACHE_NAME = "dnis"
 const OBJECT_NEW_TABLE_NAME = "objects_new"
 const USERSUM_CACHE_NAME = "userfeesum"
 const OBJECT_DEL_TABLE_NAME = "objects_del"
+const EVENT_OUTBOX_TABLE_NAME = "event_outbox"
 
 const SHARD_UP_TABLE_NAME = "shards_upload"
 
@@ -73,6 +74,7 @@ type CacheBaseSource struct {
 	obj_c      *mongo.Collection
 	del_c      *mongo.Collection
 	sum_c      *mongo.Collection
+	event_c    *mongo.Collection
 	shard_up_c sync.Map
 }
 
@@ -86,6 +88,11 @@ func (source *CacheBaseSource) initMetaDB() {
 	source.obj_c = source.db.Collection(OBJECT_NEW_TABLE_NAME)
 	source.del_c = source.db.Collection(OBJECT_DEL_TABLE_NAME)
 	source.sum_c = source.db.Collection(USERSUM_CACHE_NAME)
+	source.event_c = source.db.Collection(EVENT_OUTBOX_TABLE_NAME)
+	index := mongo.IndexModel{Keys: bson.M{"nextTime": 1}}
+	source.event_c.Indexes().CreateOne(context.Background(), index)
+	index = mongo.IndexModel{Keys: bson.M{"UID": 1}}
+	source.event_c.Indexes().CreateOne(context.Background(), index)
 	logrus.Infof("[InitMongo]Create cache tables Success.\n")
 }
 
@@ -133,3 +140,7 @@ func (source *CacheBaseSource) GetDELColl() *mongo.Collection {
 func (source *CacheBaseSource) GetOBJColl() *mongo.Collection {
 	return source.obj_c
 }
+
+func (source *CacheBaseSource) GetEventColl() *mongo.Collection {
+	return source.event_c
+}
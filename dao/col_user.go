@@ -16,6 +16,7 @@ const BUCKET_INDEX_NAME = "BUKNAME"
 
 const FILE_TABLE_NAME = "files"
 const FILE_INDEX_NAME = "BID_NAME"
+const FILE_TAG_INDEX_NAME = "BID_TAG_ID"
 
 const OBJECT_TABLE_NAME = "objects"
 const OBJECT_INDEX_NAME = "VNU"
@@ -66,6 +67,11 @@ func (source *UserMetaSource) initMetaDB() {
 		Options: options.Index().SetUnique(true).SetName(FILE_INDEX_NAME),
 	}
 	source.file_c.Indexes().CreateOne(context.Background(), index2)
+	indextag := mongo.IndexModel{
+		Keys:    bson.D{{Key: "bucketId", Value: 1}, {Key: "version.tags.k", Value: 1}, {Key: "version.tags.v", Value: 1}, {Key: "_id", Value: 1}},
+		Options: options.Index().SetUnique(false).SetName(FILE_TAG_INDEX_NAME),
+	}
+	source.file_c.Indexes().CreateOne(context.Background(), indextag)
 	source.object_c = source.db.Collection(OBJECT_TABLE_NAME)
 	index3 := mongo.IndexModel{
 		Keys:    bson.M{"VNU": 1},
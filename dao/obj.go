@@ -19,6 +19,7 @@ type ObjectMeta struct {
 	Length    uint64             `bson:"length"`
 	Usedspace uint64             `bson:"usedspace"`
 	BlockList [][]byte           `bson:"blocks"`
+	SSE       []byte             `bson:"SSE,omitempty"`
 	UserId    int32              `bson:"-"`
 }
 
@@ -43,7 +44,7 @@ func (om *ObjectMeta) GetAndUpdateEnd(usedSpace uint64) error {
 func (om *ObjectMeta) ChecekVNUExists() (bool, error) {
 	source := NewUserMetaSource(uint32(om.UserId))
 	filter := bson.M{"VNU": om.VNU}
-	opt := options.FindOne().SetProjection(bson.M{"NLINK": 1})
+	opt := options.FindOne().SetProjection(bson.M{"NLINK": 1, "length": 1})
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	err := source.GetObjectColl().FindOne(ctx, filter, opt).Decode(om)
@@ -113,6 +114,43 @@ func (om *ObjectMeta) UpdateLength() error {
 	return nil
 }
 
+// Rekey moves an object initialized under a provisional VHW to its real one.
+// The object is inserted under the new VHW before the provisional one is
+// deleted, so it is never missing. Rekey returns false if the user already
+// owns an object with that VHW.
+func (om *ObjectMeta) Rekey(vhw []byte) (bool, error) {
+	exist := NewObjectMeta(om.UserId, vhw)
+	has, err := exist.IsExists()
+	if err != nil || has {
+		return false, err
+	}
+	err = om.GetByVHW()
+	if err != nil {
+		return false, err
+	}
+	old := om.VHW
+	om.VHW = vhw
+	err = om.Insert()
+	om.VHW = old
+	if err != nil {
+		if has, _ = exist.IsExists(); has {
+			return false, nil
+		}
+		return false, err
+	}
+	source := NewUserMetaSource(uint32(om.UserId))
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err = source.GetObjectColl().DeleteOne(ctx, bson.M{"_id": old})
+	if err != nil {
+		logrus.Errorf("[ObjectMeta]Rekey ERR:%s\n", err)
+		source.GetObjectColl().DeleteOne(ctx, bson.M{"_id": vhw})
+		return false, err
+	}
+	om.VHW = vhw
+	return true, nil
+}
+
 func (om *ObjectMeta) Insert() error {
 	source := NewUserMetaSource(uint32(om.UserId))
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -331,7 +369,7 @@ func ListObjects(userid uint32, startVnu primitive.ObjectID, limit int) (uint64,
 		startVnu = primitive.NilObjectID
 	}
 	if curerr := cur.Err(); curerr != nil {
-		logrus.Errorf("[ObjectMeta]ListObjects Cursor ERR:%s, file count:%d\n", count)
+		logrus.Errorf("[ObjectMeta]ListObjects Cursor ERR:%s, file count:%d\n", curerr, count)
 		return 0, startVnu, curerr
 	}
 	return usedspace, startVnu, nil
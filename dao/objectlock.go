@@ -0,0 +1,162 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var ErrObjectLocked = errors.New("object locked")
+
+// lockedCond matches file versions which may not be deleted at now.
+func lockedCond(now time.Time, bypass bool) bson.M {
+	retained := bson.M{"retainUntil": bson.M{"$gt": now.Unix()}}
+	if bypass {
+		retained["lockMode"] = bson.M{"$ne": "GOVERNANCE"}
+	}
+	return bson.M{"$or": bson.A{bson.M{"legalHold": true}, retained}}
+}
+
+func (fm *FileMeta) version() bson.M {
+	ver := bson.M{"versionId": fm.VersionId, "meta": fm.Meta, "acl": fm.Acl}
+	if fm.LockMode != "" {
+		ver["lockMode"] = fm.LockMode
+		ver["retainUntil"] = fm.RetainUntil
+	}
+	if fm.LegalHold {
+		ver["legalHold"] = true
+	}
+	return ver
+}
+
+// lockedError tells a delete which matched nothing because of a lock from
+// one whose target does not exist.
+func (fm *FileMeta) lockedError(source *UserMetaSource, filter bson.M) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	n, err := source.GetFileColl().CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil {
+		logrus.Errorf("[S3FileMeta]CheckLocked UserID:%d,ERR:%s\n", fm.UserId, err)
+		return err
+	}
+	if n > 0 {
+		return ErrObjectLocked
+	}
+	return nil
+}
+
+func (fm *FileMeta) GetFileLock() error {
+	source := NewUserMetaSource(uint32(fm.UserId))
+	var opt *options.FindOneOptions
+	var filter bson.M
+	if fm.VersionId == primitive.NilObjectID {
+		filter = bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName}
+		opt = options.FindOne().SetProjection(bson.M{"_id": 1, "version.versionId": 1, "version.meta": 1, "version.lockMode": 1,
+			"version.retainUntil": 1, "version.legalHold": 1, "version": bson.M{"$slice": -1}})
+	} else {
+		filter = bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName, "version.versionId": fm.VersionId}
+		opt = options.FindOne().SetProjection(bson.M{"_id": 1, "version.$": 1})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res := &FileMetaWithVersion{}
+	err := source.GetFileColl().FindOne(ctx, filter, opt).Decode(res)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logrus.Errorf("[S3FileMeta]GetFileLock %s/%s ERR:%s\n", fm.BucketId.Hex(), fm.FileName, err)
+		}
+		return err
+	}
+	if len(res.Version) == 0 {
+		return mongo.ErrNoDocuments
+	}
+	ver := res.Version[0]
+	fm.FileId = res.FileId
+	fm.VersionId = ver.VersionId
+	fm.Meta = ver.Meta
+	fm.LockMode = ver.LockMode
+	fm.RetainUntil = ver.RetainUntil
+	fm.LegalHold = ver.LegalHold
+	return nil
+}
+
+// UpdateFileLock replaces the retention, legal hold and meta of a version.
+func (fm *FileMeta) UpdateFileLock() error {
+	source := NewUserMetaSource(uint32(fm.UserId))
+	filter := bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName, "version.versionId": fm.VersionId}
+	set := bson.M{"version.$.meta": fm.Meta}
+	unset := bson.M{}
+	if fm.LockMode != "" {
+		set["version.$.lockMode"] = fm.LockMode
+		set["version.$.retainUntil"] = fm.RetainUntil
+	} else {
+		unset["version.$.lockMode"] = ""
+		unset["version.$.retainUntil"] = ""
+	}
+	if fm.LegalHold {
+		set["version.$.legalHold"] = true
+	} else {
+		unset["version.$.legalHold"] = ""
+	}
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := source.GetFileColl().UpdateOne(ctx, filter, update)
+	if err != nil {
+		logrus.Errorf("[S3FileMeta]UpdateFileLock UserID:%d,%s/%s ERR:%s\n", fm.UserId, fm.BucketId.Hex(), fm.FileName, err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// ListLockedVersions returns the ids of all file versions of the user which
+// are under legal hold or retention at now.
+func ListLockedVersions(uid uint32, now time.Time) (map[primitive.ObjectID]bool, error) {
+	source := NewUserMetaSource(uid)
+	cond := lockedCond(now, false)
+	filter := bson.M{"version": bson.M{"$elemMatch": cond}}
+	opt := options.Find().SetProjection(bson.M{"_id": 1, "version.versionId": 1, "version.lockMode": 1,
+		"version.retainUntil": 1, "version.legalHold": 1})
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+	cur, err := source.GetFileColl().Find(ctx, filter, opt)
+	defer func() {
+		if cur != nil {
+			cur.Close(ctx)
+		}
+	}()
+	if err != nil {
+		logrus.Errorf("[S3FileMeta]ListLockedVersions UserID:%d,ERR:%s\n", uid, err)
+		return nil, err
+	}
+	locked := make(map[primitive.ObjectID]bool)
+	for cur.Next(ctx) {
+		res := &FileMetaWithVersion{}
+		if err = cur.Decode(res); err != nil {
+			logrus.Errorf("[S3FileMeta]ListLockedVersions Decode ERR:%s\n", err)
+			return nil, err
+		}
+		for _, ver := range res.Version {
+			if ver.LegalHold || ver.RetainUntil > now.Unix() {
+				locked[ver.VersionId] = true
+			}
+		}
+	}
+	if curerr := cur.Err(); curerr != nil {
+		logrus.Errorf("[S3FileMeta]ListLockedVersions Cursor ERR:%s\n", curerr)
+		return nil, curerr
+	}
+	return locked, nil
+}
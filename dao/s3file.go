@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/sirupsen/logrus"
 	"github.com/yottachain/YTCoreService/env"
@@ -22,20 +23,36 @@ type FileMetaWithVersion struct {
 }
 
 type FileVerion struct {
-	VersionId primitive.ObjectID `bson:"versionId"`
-	Meta      []byte             `bson:"meta"`
-	Acl       []byte             `bson:"acl"`
+	VersionId    primitive.ObjectID `bson:"versionId"`
+	Meta         []byte             `bson:"meta"`
+	Acl          []byte             `bson:"acl"`
+	Tags         []*FileTag         `bson:"tags,omitempty"`
+	LockMode     string             `bson:"lockMode,omitempty"`
+	RetainUntil  int64              `bson:"retainUntil,omitempty"`
+	LegalHold    bool               `bson:"legalHold,omitempty"`
+	CurrentSince int64              `bson:"currentSince,omitempty"`
+}
+
+type FileTag struct {
+	Key   string `bson:"k"`
+	Value string `bson:"v"`
 }
 
 type FileMeta struct {
-	FileId    primitive.ObjectID
-	BucketId  primitive.ObjectID
-	FileName  string
-	VersionId primitive.ObjectID
-	Meta      []byte
-	Acl       []byte
-	UserId    int32
-	Latest    bool
+	FileId      primitive.ObjectID
+	BucketId    primitive.ObjectID
+	FileName    string
+	VersionId   primitive.ObjectID
+	Meta        []byte
+	Acl         []byte
+	UserId      int32
+	Latest      bool
+	Tags        []*FileTag
+	LockMode    string
+	RetainUntil int64
+	LegalHold   bool
+	// BypassGovernance lets deletes remove versions under governance retention.
+	BypassGovernance bool
 }
 
 func (fm *FileMeta) GetFileMeta() error {
@@ -98,15 +115,16 @@ func (fm *FileMeta) GetLastFileMeta(justversion bool) error {
 
 func (fm *FileMeta) DeleteFileMeta() (*FileMetaWithVersion, error) {
 	source := NewUserMetaSource(uint32(fm.UserId))
-	filter := bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName}
-	opt := options.FindOneAndDelete().SetProjection(bson.M{"_id": 1, "version.versionId": 1})
+	filter := bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName,
+		"version": bson.M{"$not": bson.M{"$elemMatch": lockedCond(time.Now(), fm.BypassGovernance)}}}
+	opt := options.FindOneAndDelete().SetProjection(bson.M{"_id": 1, "version.versionId": 1, "version.meta": 1})
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	res := &FileMetaWithVersion{}
 	err := source.GetFileColl().FindOneAndDelete(ctx, filter, opt).Decode(res)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, nil
+			return nil, fm.lockedError(source, bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName})
 		} else {
 			logrus.Errorf("[S3FileMeta]DeleteFileMeta UserID:%d,ERR:%s\n", fm.UserId, err)
 			return nil, err
@@ -117,8 +135,11 @@ func (fm *FileMeta) DeleteFileMeta() (*FileMetaWithVersion, error) {
 
 func (fm *FileMeta) DeleteFileMetaByVersion() (*FileMetaWithVersion, error) {
 	source := NewUserMetaSource(uint32(fm.UserId))
-	filter := bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName}
-	opt := options.FindOneAndUpdate().SetProjection(bson.M{"_id": 1, "version.versionId": 1})
+	locked := lockedCond(time.Now(), fm.BypassGovernance)
+	locked["versionId"] = fm.VersionId
+	filter := bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName,
+		"version": bson.M{"$not": bson.M{"$elemMatch": locked}}}
+	opt := options.FindOneAndUpdate().SetProjection(bson.M{"_id": 1, "version.versionId": 1, "version.meta": 1})
 	update := bson.M{"$pull": bson.M{"version": bson.M{"versionId": fm.VersionId}}}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -126,7 +147,7 @@ func (fm *FileMeta) DeleteFileMetaByVersion() (*FileMetaWithVersion, error) {
 	err := source.GetFileColl().FindOneAndUpdate(ctx, filter, update, opt).Decode(res)
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, nil
+			return nil, fm.lockedError(source, bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName, "version.versionId": fm.VersionId})
 		} else {
 			logrus.Errorf("[S3FileMeta]DeleteFileMetaByVersion UserID:%d,ERR:%s\n", fm.UserId, err)
 			return nil, err
@@ -166,7 +187,7 @@ func (fm *FileMeta) SaveFileMeta() error {
 	source := NewUserMetaSource(uint32(fm.UserId))
 	filter := bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName}
 	update := bson.M{"$set": filter,
-		"$addToSet": bson.M{"version": bson.M{"versionId": fm.VersionId, "meta": fm.Meta, "acl": fm.Acl}}}
+		"$addToSet": bson.M{"version": fm.version()}}
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	opt := options.Update().SetUpsert(true)
@@ -280,3 +301,299 @@ func ListFileMeta(uid uint32, bid primitive.ObjectID, prefix string, nFileName s
 	}
 	return result, nil
 }
+
+func (fm *FileMeta) GetFileTags() error {
+	source := NewUserMetaSource(uint32(fm.UserId))
+	var opt *options.FindOneOptions
+	var filter bson.M
+	if fm.VersionId == primitive.NilObjectID {
+		filter = bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName}
+		opt = options.FindOne().SetProjection(bson.M{"_id": 1, "version.versionId": 1, "version.tags": 1, "version": bson.M{"$slice": -1}})
+	} else {
+		filter = bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName, "version.versionId": fm.VersionId}
+		opt = options.FindOne().SetProjection(bson.M{"_id": 1, "version.$": 1})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res := &FileMetaWithVersion{}
+	err := source.GetFileColl().FindOne(ctx, filter, opt).Decode(res)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logrus.Errorf("[S3FileMeta]GetFileTags %s/%s ERR:%s\n", fm.BucketId.Hex(), fm.FileName, err)
+		}
+		return err
+	}
+	if len(res.Version) == 0 {
+		return mongo.ErrNoDocuments
+	}
+	fm.FileId = res.FileId
+	fm.VersionId = res.Version[0].VersionId
+	fm.Tags = res.Version[0].Tags
+	return nil
+}
+
+func (fm *FileMeta) UpdateFileTags() error {
+	if fm.VersionId == primitive.NilObjectID {
+		if err := fm.GetLastFileMeta(true); err != nil {
+			return err
+		}
+	}
+	source := NewUserMetaSource(uint32(fm.UserId))
+	filter := bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName, "version.versionId": fm.VersionId}
+	update := bson.M{"$set": bson.M{"version.$.tags": fm.Tags}}
+	if len(fm.Tags) == 0 {
+		update = bson.M{"$unset": bson.M{"version.$.tags": ""}}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := source.GetFileColl().UpdateOne(ctx, filter, update)
+	if err != nil {
+		logrus.Errorf("[S3FileMeta]UpdateFileTags UserID:%d,%s/%s ERR:%s\n", fm.UserId, fm.BucketId.Hex(), fm.FileName, err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// SetCurrentSince records when the version became the current one again
+// after the newer versions expired.
+func (fm *FileMeta) SetCurrentSince(t time.Time) error {
+	source := NewUserMetaSource(uint32(fm.UserId))
+	filter := bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName, "version.versionId": fm.VersionId}
+	update := bson.M{"$set": bson.M{"version.$.currentSince": t.Unix()}}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := source.GetFileColl().UpdateOne(ctx, filter, update)
+	if err != nil {
+		logrus.Errorf("[S3FileMeta]SetCurrentSince UserID:%d,%s/%s ERR:%s\n", fm.UserId, fm.BucketId.Hex(), fm.FileName, err)
+		return err
+	}
+	return nil
+}
+
+func (fm *FileMeta) GetFileAcl() error {
+	source := NewUserMetaSource(uint32(fm.UserId))
+	var opt *options.FindOneOptions
+	var filter bson.M
+	if fm.VersionId == primitive.NilObjectID {
+		filter = bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName}
+		opt = options.FindOne().SetProjection(bson.M{"_id": 1, "version.versionId": 1, "version.acl": 1, "version": bson.M{"$slice": -1}})
+	} else {
+		filter = bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName, "version.versionId": fm.VersionId}
+		opt = options.FindOne().SetProjection(bson.M{"_id": 1, "version.$": 1})
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res := &FileMetaWithVersion{}
+	err := source.GetFileColl().FindOne(ctx, filter, opt).Decode(res)
+	if err != nil {
+		if err != mongo.ErrNoDocuments {
+			logrus.Errorf("[S3FileMeta]GetFileAcl %s/%s ERR:%s\n", fm.BucketId.Hex(), fm.FileName, err)
+		}
+		return err
+	}
+	if len(res.Version) == 0 {
+		return mongo.ErrNoDocuments
+	}
+	fm.FileId = res.FileId
+	fm.VersionId = res.Version[0].VersionId
+	fm.Acl = res.Version[0].Acl
+	return nil
+}
+
+func (fm *FileMeta) UpdateFileAcl() error {
+	if fm.VersionId == primitive.NilObjectID {
+		if err := fm.GetLastFileMeta(true); err != nil {
+			return err
+		}
+	}
+	source := NewUserMetaSource(uint32(fm.UserId))
+	filter := bson.M{"bucketId": fm.BucketId, "fileName": fm.FileName, "version.versionId": fm.VersionId}
+	update := bson.M{"$set": bson.M{"version.$.acl": fm.Acl}}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	res, err := source.GetFileColl().UpdateOne(ctx, filter, update)
+	if err != nil {
+		logrus.Errorf("[S3FileMeta]UpdateFileAcl UserID:%d,%s/%s ERR:%s\n", fm.UserId, fm.BucketId.Hex(), fm.FileName, err)
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (v *FileVerion) HasTag(key, value string) bool {
+	for _, tag := range v.Tags {
+		if tag.Key == key && (value == "" || tag.Value == value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ListFileMetaByTag returns up to maxline objects whose latest version
+// carries the tag, in _id order after startId. Older versions holding the
+// tag also match the index and are skipped, so the scan runs in pages of
+// maxline plus slack until enough objects are found.
+func ListFileMetaByTag(uid uint32, bid primitive.ObjectID, key, value string, startId primitive.ObjectID, maxline int64) ([]*FileMetaWithVersion, error) {
+	source := NewUserMetaSource(uid)
+	match := bson.M{"k": key}
+	if value != "" {
+		match["v"] = value
+	}
+	fields := bson.M{"_id": 1, "bucketId": 1, "fileName": 1, "version.versionId": 1, "version.meta": 1, "version.acl": 1, "version.tags": 1, "version": bson.M{"$slice": -1}}
+	limit := maxline + maxline/2 + 10
+	opt := options.Find().SetProjection(fields).SetSort(bson.M{"_id": 1}).SetLimit(limit)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	result := []*FileMetaWithVersion{}
+	for {
+		filter := bson.M{"bucketId": bid, "version.tags": bson.M{"$elemMatch": match}}
+		if startId != primitive.NilObjectID {
+			filter["_id"] = bson.M{"$gt": startId}
+		}
+		cur, err := source.GetFileColl().Find(ctx, filter, opt)
+		if err != nil {
+			logrus.Errorf("[S3FileMeta]ListFileMetaByTag ERR:%s\n", err)
+			return nil, err
+		}
+		read := int64(0)
+		for cur.Next(ctx) {
+			read++
+			res := &FileMetaWithVersion{}
+			if err = cur.Decode(res); err != nil {
+				break
+			}
+			startId = res.FileId
+			if len(res.Version) == 0 || !res.Version[0].HasTag(key, value) {
+				continue
+			}
+			result = append(result, res)
+			if int64(len(result)) >= maxline {
+				break
+			}
+		}
+		if err == nil {
+			err = cur.Err()
+		}
+		cur.Close(ctx)
+		if err != nil {
+			logrus.Errorf("[S3FileMeta]ListFileMetaByTag Cursor ERR:%s\n", err)
+			return nil, err
+		}
+		if int64(len(result)) >= maxline || read < limit {
+			return result, nil
+		}
+	}
+}
+
+// nameSuccessor returns the smallest name greater than every name starting
+// with s, or "" when no such name exists.
+func nameSuccessor(s string) string {
+	for len(s) > 0 {
+		r, size := utf8.DecodeLastRuneInString(s)
+		s = s[:len(s)-size]
+		if r == utf8.MaxRune || (r == utf8.RuneError && size == 1) {
+			continue
+		}
+		r++
+		if r >= 0xD800 && r <= 0xDFFF {
+			r = 0xE000
+		}
+		return s + string(r)
+	}
+	return ""
+}
+
+func delimitedPrefix(name, prefix, delimiter string) (string, bool) {
+	if delimiter == "" || !strings.HasPrefix(name, prefix) {
+		return "", false
+	}
+	if i := strings.Index(name[len(prefix):], delimiter); i >= 0 {
+		return name[:len(prefix)+i+len(delimiter)], true
+	}
+	return "", false
+}
+
+// ListFileMetaByName lists the latest version of each file in name order,
+// starting after marker. With a delimiter every name containing it past the
+// prefix is rolled up into a common prefix and the whole subtree is skipped
+// with a single index seek. Files and prefixes both count towards maxline.
+func ListFileMetaByName(uid uint32, bid primitive.ObjectID, prefix, delimiter, marker string, maxline int64) ([]*FileMetaWithVersion, []string, bool, error) {
+	source := NewUserMetaSource(uid)
+	start, inclusive := marker, false
+	if start < prefix {
+		start, inclusive = prefix, true
+	}
+	if cp, ok := delimitedPrefix(start, prefix, delimiter); ok {
+		if start = nameSuccessor(cp); start == "" {
+			return []*FileMetaWithVersion{}, []string{}, false, nil
+		}
+		inclusive = true
+	}
+	end := nameSuccessor(prefix)
+	fields := bson.M{"_id": 1, "bucketId": 1, "fileName": 1, "version.versionId": 1, "version.meta": 1, "version.acl": 1, "version": bson.M{"$slice": -1}}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	files := []*FileMetaWithVersion{}
+	prefixes := []string{}
+	count := int64(0)
+	for {
+		cond := bson.M{"$gt": start}
+		if inclusive {
+			cond = bson.M{"$gte": start}
+		}
+		if end != "" {
+			cond["$lt"] = end
+		}
+		filter := bson.M{"bucketId": bid, "fileName": cond}
+		if count >= maxline {
+			n, err := source.GetFileColl().CountDocuments(ctx, filter, options.Count().SetLimit(1))
+			if err != nil {
+				logrus.Errorf("[S3FileMeta]ListFileMetaByName ERR:%s\n", err)
+				return nil, nil, false, err
+			}
+			return files, prefixes, n > 0, nil
+		}
+		limit := maxline - count
+		opt := options.Find().SetProjection(fields).SetSort(bson.D{{Key: "fileName", Value: 1}}).SetLimit(limit)
+		cur, err := source.GetFileColl().Find(ctx, filter, opt)
+		if err != nil {
+			logrus.Errorf("[S3FileMeta]ListFileMetaByName ERR:%s\n", err)
+			return nil, nil, false, err
+		}
+		skipped, read := false, int64(0)
+		for cur.Next(ctx) {
+			read++
+			res := &FileMetaWithVersion{}
+			if err = cur.Decode(res); err != nil {
+				break
+			}
+			if cp, ok := delimitedPrefix(res.FileName, prefix, delimiter); ok {
+				prefixes = append(prefixes, cp)
+				count++
+				start, inclusive, skipped = nameSuccessor(cp), true, true
+				break
+			}
+			start, inclusive = res.FileName, false
+			if len(res.Version) > 0 {
+				files = append(files, res)
+				count++
+			}
+		}
+		if err == nil {
+			err = cur.Err()
+		}
+		cur.Close(ctx)
+		if err != nil {
+			logrus.Errorf("[S3FileMeta]ListFileMetaByName Cursor ERR:%s\n", err)
+			return nil, nil, false, err
+		}
+		if skipped && start == "" || !skipped && read < limit {
+			return files, prefixes, false, nil
+		}
+	}
+}
@@ -13,9 +13,8 @@ const (
 
 	CostSumCycle uint64 = PPC * 1 * 1000 * 60 * 60 * 24
 
-	READFILE_BUF_SIZE     = 64 * 1024
-	Max_Memory_Usage      = 1024 * 1024 * 10
-	Compress_Reserve_Size = 16 * 1024
+	READFILE_BUF_SIZE = 64 * 1024
+	Max_Memory_Usage  = 1024 * 1024 * 10
 )
 
 const SN_RETRY_WAIT = 5
@@ -23,6 +22,10 @@ const SN_RETRY_TIMES = 12 * 5
 
 var ShardNumPerNode = 1
 var Compress = true
+var CompressCodec = "zlib"
+var CDC = false
+var BlockFormat = "gcm"
+var CDCAvgSize int64 = 512 * 1024
 var Default_Block_Size int64 = 1024*1024*2 - 1 - 128
 var Max_Shard_Count int64 = 128
 var Default_PND int64 = 36
@@ -31,6 +34,10 @@ var LRCInit = 13
 
 func initCodecArgs(config *Config) {
 	Compress = config.GetBool("Compress", true)
+	CompressCodec = config.GetLowerString("CompressCodec", "zlib")
+	if CompressCodec != "zstd" && CompressCodec != "lz4" && CompressCodec != "zlib" && CompressCodec != "none" {
+		logrus.Panicf("Invalid parameter value.CompressCodec=%s\n", CompressCodec)
+	}
 	pfl := config.GetRangeInt("PFL", 16, 1024*2, 16)
 	if pfl%16 > 0 {
 		logrus.Panicf("Invalid parameter value.PFL=%d\n", pfl)
@@ -44,5 +51,11 @@ func initCodecArgs(config *Config) {
 	remain := Default_Block_Size % 16
 	Default_Block_Size = Default_Block_Size - 1 - remain
 	LRCInit = config.GetRangeInt("LRCInit", 1, 100, 13)
+	BlockFormat = config.GetLowerString("BlockFormat", "gcm")
+	if BlockFormat != "cbc" && BlockFormat != "gcm" {
+		logrus.Panicf("Invalid parameter value.BlockFormat=%s\n", BlockFormat)
+	}
+	CDC = config.GetBool("CDC", false)
+	CDCAvgSize = int64(config.GetRangeInt("CDCAvgSize", 64, 1024*4, 512)) * 1024
 
 }
@@ -31,6 +31,15 @@ var HttpRemoteIp string
 
 var SUM_SERVICE bool = false
 
+var LifecycleInterval = 6
+
+var (
+	NotifyRoutine   = 4
+	NotifyMaxRetry  = 12
+	NotifyMaxQueued = 10000
+	NotifyAllowHost string
+)
+
 func readSnProperties() {
 	confpath := YTSN_HOME + "conf/server.properties"
 	config, err := NewConfig(confpath)
@@ -66,6 +75,11 @@ func readSnProperties() {
 	feeConfig(config)
 
 	SUM_SERVICE = config.GetBool("SUM_SERVICE", false)
+	LifecycleInterval = config.GetRangeInt("lifecycleInterval", 1, 24*7, 6)
+	NotifyRoutine = config.GetRangeInt("NOTIFY_ROUTINE", 1, 64, 4)
+	NotifyMaxRetry = config.GetRangeInt("notifyMaxRetry", 0, 100, 12)
+	NotifyMaxQueued = config.GetRangeInt("notifyMaxQueued", 100, 10000000, 10000)
+	NotifyAllowHost = config.GetString("notifyAllowHost", "")
 }
 
 var (
@@ -115,25 +115,33 @@ func upConfig(config *Config) {
 }
 
 var (
-	DownloadRetryTimes int = 3
-	DownloadThread     int = 200
+	DownloadRetryTimes  int = 3
+	DownloadThread      int = 200
+	DownloadPrefetch    int = 4
+	DownloadPrefetchMem int = 256
 )
 
 func downConfig(config *Config) {
 	DownloadRetryTimes = config.GetRangeInt("downloadRetryTimes", 3, 10, 3)
 	DownloadThread = config.GetRangeInt("downloadThread", 328, 328*4, 328*2)
+	DownloadPrefetch = config.GetRangeInt("downloadPrefetch", 0, 64, 4)
+	DownloadPrefetchMem = config.GetRangeInt("downloadPrefetchMem", 16, 1024*16, 256)
 }
 
 var (
-	CertFilePath string
-	KeyFilePath  string
-	S3Port       int = 8083
+	CertFilePath    string
+	KeyFilePath     string
+	S3Port          int = 8083
+	S3WebsitePort   int
+	S3WebsiteDomain string
 )
 
 func readCert(config *Config) {
 	CertFilePath = YTFS_HOME + "crt/server.crt"
 	KeyFilePath = YTFS_HOME + "crt/server.key"
 	S3Port = config.GetRangeInt("S3Port", 8000, 20000, 8083)
+	S3WebsitePort = config.GetRangeInt("S3WebsitePort", 0, 20000, 0)
+	S3WebsiteDomain = config.GetLowerString("S3WebsiteDomain", "")
 	_, err1 := ioutil.ReadFile(CertFilePath)
 	_, err2 := ioutil.ReadFile(KeyFilePath)
 	if err1 != nil || err2 != nil {
@@ -11,12 +11,14 @@ require (
 	github.com/gobuffalo/packr/v2 v2.2.0
 	github.com/golang/protobuf v1.4.2
 	github.com/kardianos/service v1.2.1
+	github.com/klauspost/compress v1.9.5
 	github.com/lestrrat-go/file-rotatelogs v2.3.0+incompatible
 	github.com/libp2p/go-libp2p-core v0.3.1
 	github.com/mr-tron/base58 v1.1.3
 	github.com/multiformats/go-multiaddr v0.2.0
 	github.com/multiformats/go-multiaddr-net v0.1.2
 	github.com/patrickmn/go-cache v2.1.0+incompatible
+	github.com/pierrec/lz4/v4 v4.1.17
 	github.com/rifflock/lfshook v0.0.0-20180920164130-b9218ef580f5
 	github.com/ryszard/goskiplist v0.0.0-20150312221310-2dfbae5fcf46
 	github.com/shopspring/decimal v1.3.1
@@ -64,7 +66,6 @@ require (
 	github.com/json-iterator/go v1.1.10 // indirect
 	github.com/juju/ratelimit v1.0.1 // indirect
 	github.com/karrick/godirwalk v1.10.3 // indirect
-	github.com/klauspost/compress v1.9.5 // indirect
 	github.com/leodido/go-urn v1.2.0 // indirect
 	github.com/lestrrat-go/strftime v1.0.1 // indirect
 	github.com/libp2p/go-openssl v0.0.4 // indirect
@@ -46,7 +46,11 @@ func (h *CreateBucketHandler) Handle() proto.Message {
 		logrus.Errorf("[CreateBucket]UID:%d,ERR:TOO_MANY_BUCKETS %d\n", h.user.UserID, num)
 		return pkt.NewError(pkt.TOO_MANY_BUCKETS)
 	}
-	meta := &dao.BucketMeta{UserId: h.user.UserID, BucketId: primitive.NewObjectID(), Meta: h.m.Meta, BucketName: name}
+	bs, err := pkt.KeepBucketMeta(nil, h.m.Meta)
+	if err != nil {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, err.Error())
+	}
+	meta := &dao.BucketMeta{UserId: h.user.UserID, BucketId: primitive.NewObjectID(), Meta: bs, BucketName: name}
 	err = dao.SaveBucketMeta(meta)
 	if err != nil {
 		return pkt.NewError(pkt.SERVER_ERROR)
@@ -163,8 +167,66 @@ func (h *UpdateBucketHandler) Handle() proto.Message {
 	if err != nil {
 		return pkt.NewError(pkt.INVALID_BUCKET_NAME)
 	}
-	nmeta := &dao.BucketMeta{BucketId: bmeta.BucketId, BucketName: bmeta.BucketName, Meta: h.m.Meta, UserId: h.user.UserID}
-	err = dao.UpdateBucketMeta(nmeta)
+	var errmsg *pkt.ErrorMessage
+	err = dao.ModifyBucketMeta(bmeta, func(old []byte) ([]byte, error) {
+		bs, err := pkt.KeepBucketMeta(old, h.m.Meta)
+		if err != nil {
+			errmsg = pkt.NewErrorMsg(pkt.INVALID_ARGS, err.Error())
+		}
+		return bs, err
+	})
+	if errmsg != nil {
+		return errmsg
+	}
+	if err != nil {
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	return &pkt.VoidResp{}
+}
+
+type SetBucketMetaHandler struct {
+	pkey string
+	m    *pkt.SetBucketMetaReqV2
+	user *dao.User
+}
+
+func (h *SetBucketMetaHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.ErrorMessage, *int32, *int32) {
+	h.pkey = pubkey
+	req, ok := msg.(*pkt.SetBucketMetaReqV2)
+	if ok {
+		h.m = req
+		if h.m.UserId == nil || h.m.SignData == nil || h.m.KeyNumber == nil || h.m.BucketName == nil || h.m.Key == nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+		}
+		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
+		if h.user == nil {
+			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
+		}
+		return nil, WRITE_ROUTINE_NUM, nil
+	} else {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request"), nil, nil
+	}
+}
+
+// Handle changes a single key of the bucket meta, leaving the keys other
+// requests may be setting at the same time alone.
+func (h *SetBucketMetaHandler) Handle() proto.Message {
+	logrus.Infof("[SetBucketMeta]UID:%d,Name:%s,Key:%s\n", h.user.UserID, *h.m.BucketName, *h.m.Key)
+	bmeta, err := dao.GetBucketIdFromCache(*h.m.BucketName, h.user.UserID)
+	if err != nil {
+		return pkt.NewError(pkt.INVALID_BUCKET_NAME)
+	}
+	var errmsg *pkt.ErrorMessage
+	err = dao.ModifyBucketMeta(bmeta, func(old []byte) ([]byte, error) {
+		bs, err := pkt.SetBucketMeta(old, *h.m.Key, h.m.Value)
+		if err != nil {
+			errmsg = pkt.NewErrorMsg(pkt.INVALID_ARGS, err.Error())
+		}
+		return bs, err
+	})
+	if errmsg != nil {
+		return errmsg
+	}
 	if err != nil {
 		return pkt.NewError(pkt.SERVER_ERROR)
 	}
@@ -9,6 +9,7 @@ func init() {
 	ID_HANDLER_MAP[0x3288] = func() MessageEvent { return MessageEvent(&GetBucketHandler{}) }
 	ID_HANDLER_MAP[0xd6f3] = func() MessageEvent { return MessageEvent(&DeleteBucketHandler{}) }
 	ID_HANDLER_MAP[0xde6c] = func() MessageEvent { return MessageEvent(&UpdateBucketHandler{}) }
+	ID_HANDLER_MAP[0x8d35] = func() MessageEvent { return MessageEvent(&SetBucketMetaHandler{}) }
 	ID_HANDLER_MAP[0xfd39] = func() MessageEvent { return MessageEvent(&ListBucketHandler{}) }
 
 
@@ -33,6 +34,14 @@ func init() {
 	ID_HANDLER_MAP[0x4076] = func() MessageEvent { return MessageEvent(&DeleteFileHandler{}) }
 	ID_HANDLER_MAP[0x0d8e] = func() MessageEvent { return MessageEvent(&GetObjectHandler{}) }
 	ID_HANDLER_MAP[0xc23f] = func() MessageEvent { return MessageEvent(&ListObjectHandler{}) }
+	ID_HANDLER_MAP[0x5a3e] = func() MessageEvent { return MessageEvent(&GetObjectTaggingHandler{}) }
+	ID_HANDLER_MAP[0x9c71] = func() MessageEvent { return MessageEvent(&PutObjectTaggingHandler{}) }
+	ID_HANDLER_MAP[0x2f86] = func() MessageEvent { return MessageEvent(&ListObjectByTagHandler{}) }
+	ID_HANDLER_MAP[0x6e1b] = func() MessageEvent { return MessageEvent(&GetObjectAclHandler{}) }
+	ID_HANDLER_MAP[0x3c58] = func() MessageEvent { return MessageEvent(&PutObjectAclHandler{}) }
+	ID_HANDLER_MAP[0x7a19] = func() MessageEvent { return MessageEvent(&GetObjectLockHandler{}) }
+	ID_HANDLER_MAP[0x4e63] = func() MessageEvent { return MessageEvent(&PutObjectRetentionHandler{}) }
+	ID_HANDLER_MAP[0xc1d7] = func() MessageEvent { return MessageEvent(&PutObjectLegalHoldHandler{}) }
 
 
 	ID_HANDLER_MAP[0x47fb] = func() MessageEvent { return MessageEvent(&AuthHandler{}) }
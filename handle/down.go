@@ -48,6 +48,9 @@ func (h *DownloadObjectInitHandler) Handle() proto.Message {
 			return pkt.NewError(pkt.SERVER_ERROR)
 		}
 	}
+	if errmsg := checkCustomerKey(meta, h.m.SseKeyMD5); errmsg != nil {
+		return errmsg
+	}
 	logrus.Infof("[DownloadObj]UID:%d,VNU:%s\n", h.user.UserID, meta.VNU.Hex())
 	size := uint32(len(meta.BlockList))
 	pkt.ToOldBlockList(meta.BlockList)
@@ -108,11 +111,25 @@ func (h *DownloadFileHandler) Handle() proto.Message {
 	if err != nil {
 		return pkt.NewError(pkt.INVALID_OBJECT_NAME)
 	}
+	if errmsg := checkCustomerKey(meta, h.m.SseKeyMD5); errmsg != nil {
+		return errmsg
+	}
 	size := uint32(len(meta.BlockList))
 	refs := &pkt.DownloadObjectInitResp_RefList{Count: &size, Refers: meta.BlockList}
 	return &pkt.DownloadObjectInitResp{Reflist: refs, Length: &meta.Length}
 }
 
+// checkCustomerKey withholds the refers of an object uploaded under an SSE-C
+// customer key from requests without the MD5 of that key. The KEUs of such
+// an object are wrapped by the key, so a client unaware of it would decrypt
+// garbage.
+func checkCustomerKey(meta *dao.ObjectMeta, keyMD5 []byte) *pkt.ErrorMessage {
+	if len(meta.SSE) > 0 && !bytes.Equal(meta.SSE, keyMD5) {
+		return pkt.NewErrorMsg(pkt.CUSTOMER_KEY_REQUIRED, "The object was stored with a customer key")
+	}
+	return nil
+}
+
 type DownloadBlockInitHandler struct {
 	pkey string
 	m    *pkt.DownloadBlockInitReqV2
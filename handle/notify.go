@@ -0,0 +1,62 @@
+package handle
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/dao"
+	"github.com/yottachain/YTCoreService/env"
+	"github.com/yottachain/YTCoreService/pkt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// notifyObjectEvent queues an event for every webhook of the bucket that
+// matches it; service delivers the outbox. Objects get their key only when
+// UploadFile names them, UploadObjectEnd merely completes the data, so
+// creation is reported from UploadFile and CopyObject.
+func notifyObjectEvent(user *dao.User, bmeta *dao.BucketMeta, event, key string, meta []byte, verid primitive.ObjectID) {
+	conf := pkt.NotificationFromMeta(bmeta.Meta)
+	if conf == nil {
+		return
+	}
+	var size int64
+	var etag, version string
+	if m, err := pkt.FileMetaToMap(meta, verid); err == nil {
+		size, _ = strconv.ParseInt(m["contentLength"], 10, 64)
+		etag = m["ETag"]
+	}
+	if verid != primitive.NilObjectID {
+		version = verid.Hex()
+	}
+	now := time.Now()
+	var queued int64 = -1
+	for _, w := range conf.Webhooks {
+		if !w.Matches(event, key) {
+			continue
+		}
+		if queued < 0 {
+			queued, _ = dao.CountEventLOG(user.UserID, int64(env.NotifyMaxQueued))
+		}
+		if queued >= int64(env.NotifyMaxQueued) {
+			logrus.Warnf("[Notify][%d]%s/%s,Outbox full,event %s dropped\n", user.UserID, bmeta.BucketName, key, event)
+			continue
+		}
+		body, err := pkt.NewEvent(w.ID, event, user.Username, bmeta.BucketName, key, size, etag, version, now)
+		if err != nil {
+			logrus.Errorf("[Notify][%d]%s/%s,Marshal event ERR:%s\n", user.UserID, bmeta.BucketName, key, err)
+			continue
+		}
+		if err := dao.AddEventLOG(user.UserID, w.Endpoint, body); err != nil {
+			logrus.Errorf("[Notify][%d]%s/%s,Event %s lost\n", user.UserID, bmeta.BucketName, key, event)
+			continue
+		}
+		queued++
+	}
+}
+
+// NotifyObjectRemoved reports an object version removed by the SN itself,
+// such as a lifecycle expiration.
+func NotifyObjectRemoved(user *dao.User, bmeta *dao.BucketMeta, key string, verid primitive.ObjectID) {
+	notifyObjectEvent(user, bmeta, pkt.EventObjectRemovedDelete, key, nil, verid)
+}
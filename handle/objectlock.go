@@ -0,0 +1,295 @@
+package handle
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/dao"
+	"github.com/yottachain/YTCoreService/pkt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+func fileLock(fmeta *dao.FileMeta) *pkt.ObjectLock {
+	return &pkt.ObjectLock{Mode: fmeta.LockMode, RetainUntil: fmeta.RetainUntil, LegalHold: fmeta.LegalHold}
+}
+
+func setFileLock(fmeta *dao.FileMeta, lock *pkt.ObjectLock) error {
+	m, err := pkt.FileMetaToMap(fmeta.Meta, fmeta.VersionId)
+	if err != nil {
+		return err
+	}
+	lock.SetMap(m)
+	bs, err := pkt.MarshalMap(m)
+	if err != nil {
+		return err
+	}
+	fmeta.Meta = bs
+	fmeta.LockMode, fmeta.RetainUntil, fmeta.LegalHold = lock.Mode, lock.RetainUntil, lock.LegalHold
+	return nil
+}
+
+// applyObjectLock sets the lock of a new file version from the lock keys in
+// its meta when explicit, else from the bucket default retention. A version
+// with the same id which is still locked may not be overwritten.
+func applyObjectLock(bmeta *dao.BucketMeta, fmeta *dao.FileMeta, explicit bool) *pkt.ErrorMessage {
+	conf := pkt.ObjectLockFromMeta(bmeta.Meta)
+	m, err := pkt.FileMetaToMap(fmeta.Meta, fmeta.VersionId)
+	if err != nil {
+		if conf != nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Meta")
+		}
+		return nil
+	}
+	var lock *pkt.ObjectLock
+	if explicit {
+		if lock, err = pkt.ObjectLockFromMap(m); err != nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, err.Error())
+		}
+	}
+	if conf == nil {
+		if lock != nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Bucket is missing Object Lock Configuration")
+		}
+		if _, ok := m[pkt.ObjectLockModeKey]; !ok {
+			if _, ok = m[pkt.ObjectLockLegalHoldKey]; !ok {
+				return nil
+			}
+		}
+		return setLockMeta(fmeta, nil)
+	}
+	now := time.Now()
+	if lock == nil || lock.Mode == "" {
+		if def := conf.DefaultLock(now); def != nil {
+			if lock != nil {
+				def.LegalHold = lock.LegalHold
+			}
+			lock = def
+		}
+	}
+	if lock != nil && lock.Mode != "" && lock.RetainUntil <= now.Unix() {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "The retain until date must be in the future")
+	}
+	old := &dao.FileMeta{UserId: fmeta.UserId, BucketId: fmeta.BucketId, FileName: fmeta.FileName, VersionId: fmeta.VersionId}
+	if err := old.GetFileLock(); err == nil {
+		if fileLock(old).Locked(now, false) {
+			return pkt.NewErrorMsg(pkt.OBJECT_LOCKED, "Object is WORM protected and cannot be overwritten")
+		}
+	} else if err != mongo.ErrNoDocuments {
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	return setLockMeta(fmeta, lock)
+}
+
+func setLockMeta(fmeta *dao.FileMeta, lock *pkt.ObjectLock) *pkt.ErrorMessage {
+	if lock == nil {
+		lock = &pkt.ObjectLock{}
+	}
+	if err := setFileLock(fmeta, lock); err != nil {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Meta")
+	}
+	return nil
+}
+
+func lockBucket(bname string, uid int32) (*dao.BucketMeta, *pkt.ErrorMessage) {
+	meta, _ := dao.GetBucketIdFromCache(bname, uid)
+	if meta == nil {
+		return nil, pkt.NewError(pkt.INVALID_BUCKET_NAME)
+	}
+	if pkt.ObjectLockFromMeta(meta.Meta) == nil {
+		return nil, pkt.NewErrorMsg(pkt.INVALID_ARGS, "Bucket is missing Object Lock Configuration")
+	}
+	return meta, nil
+}
+
+type GetObjectLockHandler struct {
+	pkey  string
+	m     *pkt.GetObjectLockReqV2
+	user  *dao.User
+	verid primitive.ObjectID
+}
+
+func (h *GetObjectLockHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.ErrorMessage, *int32, *int32) {
+	h.pkey = pubkey
+	req, ok := msg.(*pkt.GetObjectLockReqV2)
+	if ok {
+		h.m = req
+		if h.m.Vnu != nil {
+			if h.m.Vnu.Timestamp == nil || h.m.Vnu.MachineIdentifier == nil || h.m.Vnu.ProcessIdentifier == nil || h.m.Vnu.Counter == nil {
+				return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+			}
+			h.verid = pkt.NewObjectId(*h.m.Vnu.Timestamp, *h.m.Vnu.MachineIdentifier, *h.m.Vnu.ProcessIdentifier, *h.m.Vnu.Counter)
+		}
+		if h.m.UserId == nil || h.m.SignData == nil || h.m.KeyNumber == nil || h.m.BucketName == nil || h.m.FileName == nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+		}
+		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
+		if h.user == nil {
+			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
+		}
+		return nil, READ_ROUTINE_NUM, h.user.Routine
+	} else {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request"), nil, nil
+	}
+}
+
+func (h *GetObjectLockHandler) Handle() proto.Message {
+	logrus.Infof("[GetObjectLock]UID:%d,BucketName:%s,FileName:%s\n", h.user.UserID, *h.m.BucketName, *h.m.FileName)
+	meta, errmsg := lockBucket(*h.m.BucketName, h.user.UserID)
+	if errmsg != nil {
+		return errmsg
+	}
+	fmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: meta.BucketId, FileName: *h.m.FileName, VersionId: h.verid}
+	err := fmeta.GetFileLock()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return pkt.NewError(pkt.INVALID_OBJECT_NAME)
+		}
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	return &pkt.GetObjectLockResp{Mode: &fmeta.LockMode, RetainUntil: &fmeta.RetainUntil, LegalHold: &fmeta.LegalHold}
+}
+
+type PutObjectRetentionHandler struct {
+	pkey  string
+	m     *pkt.PutObjectRetentionReqV2
+	user  *dao.User
+	verid primitive.ObjectID
+}
+
+func (h *PutObjectRetentionHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.ErrorMessage, *int32, *int32) {
+	h.pkey = pubkey
+	req, ok := msg.(*pkt.PutObjectRetentionReqV2)
+	if ok {
+		h.m = req
+		if h.m.Vnu != nil {
+			if h.m.Vnu.Timestamp == nil || h.m.Vnu.MachineIdentifier == nil || h.m.Vnu.ProcessIdentifier == nil || h.m.Vnu.Counter == nil {
+				return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+			}
+			h.verid = pkt.NewObjectId(*h.m.Vnu.Timestamp, *h.m.Vnu.MachineIdentifier, *h.m.Vnu.ProcessIdentifier, *h.m.Vnu.Counter)
+		}
+		if h.m.UserId == nil || h.m.SignData == nil || h.m.KeyNumber == nil || h.m.BucketName == nil || h.m.FileName == nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+		}
+		if h.m.GetMode() != "" && (!pkt.ValidLockMode(h.m.GetMode()) || h.m.GetRetainUntil() <= time.Now().Unix()) {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Retention"), nil, nil
+		}
+		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
+		if h.user == nil {
+			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
+		}
+		return nil, WRITE_ROUTINE_NUM, nil
+	} else {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request"), nil, nil
+	}
+}
+
+// allowRetention checks a retention change against the current lock:
+// compliance retention can only be extended, governance retention can only
+// be shortened, removed or changed in mode with bypass.
+func allowRetention(cur *pkt.ObjectLock, mode string, until int64, bypass bool, now time.Time) bool {
+	if cur.Mode == "" || cur.RetainUntil <= now.Unix() {
+		return true
+	}
+	extended := mode == cur.Mode && until >= cur.RetainUntil
+	if cur.Mode == pkt.LockModeCompliance {
+		return extended
+	}
+	return extended || bypass
+}
+
+func (h *PutObjectRetentionHandler) Handle() proto.Message {
+	logrus.Infof("[PutObjectRetention]UID:%d,BucketName:%s,FileName:%s,mode:%s\n", h.user.UserID, *h.m.BucketName, *h.m.FileName, h.m.GetMode())
+	meta, errmsg := lockBucket(*h.m.BucketName, h.user.UserID)
+	if errmsg != nil {
+		return errmsg
+	}
+	fmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: meta.BucketId, FileName: *h.m.FileName, VersionId: h.verid}
+	err := fmeta.GetFileLock()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return pkt.NewError(pkt.INVALID_OBJECT_NAME)
+		}
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	lock := fileLock(fmeta)
+	mode, until := h.m.GetMode(), h.m.GetRetainUntil()
+	if mode == "" {
+		until = 0
+	}
+	if !allowRetention(lock, mode, until, h.m.GetBypassGovernance(), time.Now()) {
+		logrus.Warnf("[PutObjectRetention]UID:%d,%s/%s is locked in %s mode\n", h.user.UserID, *h.m.BucketName, *h.m.FileName, lock.Mode)
+		return pkt.NewErrorMsg(pkt.OBJECT_LOCKED, "Object is WORM protected and its retention cannot be reduced")
+	}
+	lock.Mode, lock.RetainUntil = mode, until
+	if errmsg := setLockMeta(fmeta, lock); errmsg != nil {
+		return errmsg
+	}
+	if err := fmeta.UpdateFileLock(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return pkt.NewError(pkt.INVALID_OBJECT_NAME)
+		}
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	return &pkt.VoidResp{}
+}
+
+type PutObjectLegalHoldHandler struct {
+	pkey  string
+	m     *pkt.PutObjectLegalHoldReqV2
+	user  *dao.User
+	verid primitive.ObjectID
+}
+
+func (h *PutObjectLegalHoldHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.ErrorMessage, *int32, *int32) {
+	h.pkey = pubkey
+	req, ok := msg.(*pkt.PutObjectLegalHoldReqV2)
+	if ok {
+		h.m = req
+		if h.m.Vnu != nil {
+			if h.m.Vnu.Timestamp == nil || h.m.Vnu.MachineIdentifier == nil || h.m.Vnu.ProcessIdentifier == nil || h.m.Vnu.Counter == nil {
+				return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+			}
+			h.verid = pkt.NewObjectId(*h.m.Vnu.Timestamp, *h.m.Vnu.MachineIdentifier, *h.m.Vnu.ProcessIdentifier, *h.m.Vnu.Counter)
+		}
+		if h.m.UserId == nil || h.m.SignData == nil || h.m.KeyNumber == nil || h.m.BucketName == nil || h.m.FileName == nil || h.m.LegalHold == nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+		}
+		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
+		if h.user == nil {
+			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
+		}
+		return nil, WRITE_ROUTINE_NUM, nil
+	} else {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request"), nil, nil
+	}
+}
+
+func (h *PutObjectLegalHoldHandler) Handle() proto.Message {
+	logrus.Infof("[PutObjectLegalHold]UID:%d,BucketName:%s,FileName:%s,hold:%t\n", h.user.UserID, *h.m.BucketName, *h.m.FileName, *h.m.LegalHold)
+	meta, errmsg := lockBucket(*h.m.BucketName, h.user.UserID)
+	if errmsg != nil {
+		return errmsg
+	}
+	fmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: meta.BucketId, FileName: *h.m.FileName, VersionId: h.verid}
+	err := fmeta.GetFileLock()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return pkt.NewError(pkt.INVALID_OBJECT_NAME)
+		}
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	lock := fileLock(fmeta)
+	lock.LegalHold = *h.m.LegalHold
+	if errmsg := setLockMeta(fmeta, lock); errmsg != nil {
+		return errmsg
+	}
+	if err := fmeta.UpdateFileLock(); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return pkt.NewError(pkt.INVALID_OBJECT_NAME)
+		}
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	return &pkt.VoidResp{}
+}
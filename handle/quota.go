@@ -0,0 +1,116 @@
+package handle
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/dao"
+	"github.com/yottachain/YTCoreService/pkt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Usage of buckets with a quota is counted once and then kept up to date by
+// the uploads and deletes of this SN; it is recounted every half hour.
+var BUCKET_USAGE_CACHE = cache.New(30*time.Minute, 10*time.Minute)
+
+var bucketUsageLock sync.Mutex
+
+type bucketUsage struct {
+	sync.Mutex
+	size  int64
+	count int64
+}
+
+func bucketUsageKey(uid int32, bid primitive.ObjectID) string {
+	return fmt.Sprintf("%d-%s", uid, bid.Hex())
+}
+
+func getBucketUsage(uid int32, bid primitive.ObjectID) (*bucketUsage, error) {
+	key := bucketUsageKey(uid, bid)
+	bucketUsageLock.Lock()
+	defer bucketUsageLock.Unlock()
+	if v, found := BUCKET_USAGE_CACHE.Get(key); found {
+		return v.(*bucketUsage), nil
+	}
+	count, size, err := dao.SumBucketFiles(uint32(uid), bid, pkt.FileMetaLength)
+	if err != nil {
+		return nil, err
+	}
+	usage := &bucketUsage{size: size, count: count}
+	BUCKET_USAGE_CACHE.SetDefault(key, usage)
+	return usage, nil
+}
+
+func (u *bucketUsage) release(length int64) {
+	u.Lock()
+	defer u.Unlock()
+	u.size = u.size - length
+	u.count--
+}
+
+// CheckBucketQuota rejects an upload that would not fit into the bucket
+// early. The quota is enforced when the object is written to the bucket.
+func CheckBucketQuota(uid int32, bname string, length int64) *pkt.ErrorMessage {
+	bmeta, err := dao.GetBucketIdFromCache(bname, uid)
+	if err != nil {
+		return pkt.NewError(pkt.INVALID_BUCKET_NAME)
+	}
+	quota := pkt.BucketQuotaFromMeta(bmeta.Meta)
+	if quota == nil {
+		return nil
+	}
+	usage, err := getBucketUsage(uid, bmeta.BucketId)
+	if err != nil {
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	usage.Lock()
+	defer usage.Unlock()
+	if !quota.Allows(usage.size, usage.count, length) {
+		return quotaExceeded(uid, bmeta, quota, usage)
+	}
+	return nil
+}
+
+// reserveBucketQuota adds an object of length bytes to the usage of bucket,
+// failing if it would exceed the quota. The returned usage, nil for buckets
+// without a quota, must be released if the object is not saved.
+func reserveBucketQuota(uid int32, bmeta *dao.BucketMeta, length int64) (*bucketUsage, *pkt.ErrorMessage) {
+	quota := pkt.BucketQuotaFromMeta(bmeta.Meta)
+	if quota == nil {
+		return nil, nil
+	}
+	usage, err := getBucketUsage(uid, bmeta.BucketId)
+	if err != nil {
+		return nil, pkt.NewError(pkt.SERVER_ERROR)
+	}
+	usage.Lock()
+	defer usage.Unlock()
+	if !quota.Allows(usage.size, usage.count, length) {
+		return nil, quotaExceeded(uid, bmeta, quota, usage)
+	}
+	usage.size = usage.size + length
+	usage.count++
+	return usage, nil
+}
+
+// releaseBucketUsage removes deleted versions from the usage of a bucket
+// if it is being counted.
+func releaseBucketUsage(uid int32, bid primitive.ObjectID, vers []*dao.FileVerion) {
+	v, found := BUCKET_USAGE_CACHE.Get(bucketUsageKey(uid, bid))
+	if !found {
+		return
+	}
+	usage := v.(*bucketUsage)
+	for _, ver := range vers {
+		usage.release(pkt.FileMetaLength(ver.Meta))
+	}
+}
+
+func quotaExceeded(uid int32, bmeta *dao.BucketMeta, quota *pkt.BucketQuota, usage *bucketUsage) *pkt.ErrorMessage {
+	logrus.Warnf("[BucketQuota]UID:%d,Bucket:%s,used %d bytes/%d objects,quota %d bytes/%d objects\n",
+		uid, bmeta.BucketName, usage.size, usage.count, quota.MaxSize, quota.MaxObjects)
+	return pkt.NewErrorMsg(pkt.BUCKET_QUOTA_EXCEEDED, "Bucket quota exceeded")
+}
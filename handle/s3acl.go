@@ -0,0 +1,113 @@
+package handle
+
+import (
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/dao"
+	"github.com/yottachain/YTCoreService/pkt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+type PutObjectAclHandler struct {
+	pkey  string
+	m     *pkt.PutObjectAclReqV2
+	user  *dao.User
+	verid primitive.ObjectID
+}
+
+func (h *PutObjectAclHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.ErrorMessage, *int32, *int32) {
+	h.pkey = pubkey
+	req, ok := msg.(*pkt.PutObjectAclReqV2)
+	if ok {
+		h.m = req
+		if h.m.Vnu != nil {
+			if h.m.Vnu.Timestamp == nil || h.m.Vnu.MachineIdentifier == nil || h.m.Vnu.ProcessIdentifier == nil || h.m.Vnu.Counter == nil {
+				return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+			}
+			h.verid = pkt.NewObjectId(*h.m.Vnu.Timestamp, *h.m.Vnu.MachineIdentifier, *h.m.Vnu.ProcessIdentifier, *h.m.Vnu.Counter)
+		}
+		if h.m.UserId == nil || h.m.SignData == nil || h.m.KeyNumber == nil || h.m.BucketName == nil || h.m.FileName == nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+		}
+		if len(h.m.Acl) > 64 {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Acl"), nil, nil
+		}
+		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
+		if h.user == nil {
+			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
+		}
+		return nil, WRITE_ROUTINE_NUM, nil
+	} else {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request"), nil, nil
+	}
+}
+
+func (h *PutObjectAclHandler) Handle() proto.Message {
+	logrus.Infof("[PutObjectAcl]UID:%d,BucketName:%s,FileName:%s,acl:%s\n", h.user.UserID, *h.m.BucketName, *h.m.FileName, string(h.m.Acl))
+	meta, _ := dao.GetBucketIdFromCache(*h.m.BucketName, h.user.UserID)
+	if meta == nil {
+		return pkt.NewError(pkt.INVALID_BUCKET_NAME)
+	}
+	acl := h.m.Acl
+	if acl == nil {
+		acl = []byte{}
+	}
+	fmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: meta.BucketId, FileName: *h.m.FileName, VersionId: h.verid, Acl: acl}
+	err := fmeta.UpdateFileAcl()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return pkt.NewError(pkt.INVALID_OBJECT_NAME)
+		}
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	return &pkt.VoidResp{}
+}
+
+type GetObjectAclHandler struct {
+	pkey  string
+	m     *pkt.GetObjectAclReqV2
+	user  *dao.User
+	verid primitive.ObjectID
+}
+
+func (h *GetObjectAclHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.ErrorMessage, *int32, *int32) {
+	h.pkey = pubkey
+	req, ok := msg.(*pkt.GetObjectAclReqV2)
+	if ok {
+		h.m = req
+		if h.m.Vnu != nil {
+			if h.m.Vnu.Timestamp == nil || h.m.Vnu.MachineIdentifier == nil || h.m.Vnu.ProcessIdentifier == nil || h.m.Vnu.Counter == nil {
+				return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+			}
+			h.verid = pkt.NewObjectId(*h.m.Vnu.Timestamp, *h.m.Vnu.MachineIdentifier, *h.m.Vnu.ProcessIdentifier, *h.m.Vnu.Counter)
+		}
+		if h.m.UserId == nil || h.m.SignData == nil || h.m.KeyNumber == nil || h.m.BucketName == nil || h.m.FileName == nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+		}
+		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
+		if h.user == nil {
+			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
+		}
+		return nil, READ_ROUTINE_NUM, h.user.Routine
+	} else {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request"), nil, nil
+	}
+}
+
+func (h *GetObjectAclHandler) Handle() proto.Message {
+	logrus.Infof("[GetObjectAcl]UID:%d,BucketName:%s,FileName:%s\n", h.user.UserID, *h.m.BucketName, *h.m.FileName)
+	meta, _ := dao.GetBucketIdFromCache(*h.m.BucketName, h.user.UserID)
+	if meta == nil {
+		return pkt.NewError(pkt.INVALID_BUCKET_NAME)
+	}
+	fmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: meta.BucketId, FileName: *h.m.FileName, VersionId: h.verid}
+	err := fmeta.GetFileAcl()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return pkt.NewError(pkt.INVALID_OBJECT_NAME)
+		}
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	return &pkt.GetObjectAclResp{Acl: fmeta.Acl}
+}
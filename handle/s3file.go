@@ -59,6 +59,7 @@ func (h *UploadFileHandler) Handle() proto.Message {
 	if h.m.Meta != nil {
 		m = h.m.Meta
 	}
+	var length int64
 	if !env.IsZeroLenFileID(h.vnu) {
 		ometa := &dao.ObjectMeta{UserId: h.user.UserID, VNU: h.vnu}
 		b, err := ometa.ChecekVNUExists()
@@ -69,13 +70,28 @@ func (h *UploadFileHandler) Handle() proto.Message {
 			logrus.Errorf("[CreateOBJ]UID:%d,%s/%s ERR:INVALID_UPLOAD_ID\n", h.user.UserID, *h.m.Bucketname, *h.m.FileName)
 			return pkt.NewError(pkt.INVALID_UPLOAD_ID)
 		}
+		length = int64(ometa.Length)
+	}
+	usage, errmsg := reserveBucketQuota(h.user.UserID, meta, length)
+	if errmsg != nil {
+		return errmsg
 	}
 	fmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: meta.BucketId, FileName: *h.m.FileName, VersionId: h.vnu, Meta: m, Acl: []byte{}}
+	if errmsg := applyObjectLock(meta, fmeta, true); errmsg != nil {
+		if usage != nil {
+			usage.release(length)
+		}
+		return errmsg
+	}
 	err := fmeta.SaveFileMeta()
 	if err != nil {
+		if usage != nil {
+			usage.release(length)
+		}
 		return pkt.NewError(pkt.SERVER_ERROR)
 	}
 	OBJ_ADD_LIST_CACHE.SetDefault(strconv.Itoa(int(h.user.UserID)), time.Now())
+	notifyObjectEvent(h.user, meta, pkt.EventObjectCreatedPut, *h.m.FileName, fmeta.Meta, h.vnu)
 	return &pkt.VoidResp{}
 }
 
@@ -119,13 +135,29 @@ func (h *CopyObjectHandler) Handle() proto.Message {
 		return pkt.NewError(pkt.INVALID_OBJECT_NAME)
 	}
 	meta := fmeta.Meta
+	length := pkt.FileMetaLength(meta)
+	usage, errmsg := reserveBucketQuota(h.user.UserID, dstmeta, length)
+	if errmsg != nil {
+		return errmsg
+	}
 	nmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: dstmeta.BucketId, FileName: *h.m.DestObjectKey,
 		Meta: meta, VersionId: fmeta.VersionId, Acl: []byte{}}
+	if errmsg := applyObjectLock(dstmeta, nmeta, false); errmsg != nil {
+		if usage != nil {
+			usage.release(length)
+		}
+		return errmsg
+	}
+	meta = nmeta.Meta
 	err = nmeta.SaveFileMeta()
 	if err != nil {
+		if usage != nil {
+			usage.release(length)
+		}
 		return pkt.NewError(pkt.SERVER_ERROR)
 	}
 	OBJ_ADD_LIST_CACHE.SetDefault(strconv.Itoa(int(h.user.UserID)), time.Now())
+	notifyObjectEvent(h.user, dstmeta, pkt.EventObjectCreatedCopy, *h.m.DestObjectKey, meta, nmeta.VersionId)
 	i1, i2, i3, i4 := pkt.ObjectIdParam(dstmeta.BucketId)
 	bucketid := &pkt.CopyObjectResp_BucketId{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
 	ii1, ii2, ii3, ii4 := pkt.ObjectIdParam(fmeta.VersionId)
@@ -170,29 +202,38 @@ func (h *DeleteFileHandler) Handle() proto.Message {
 	if meta == nil {
 		return pkt.NewError(pkt.INVALID_BUCKET_NAME)
 	}
-	var err error
-	fmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: meta.BucketId, FileName: *h.m.FileName, VersionId: h.verid}
-	var metaWVer *dao.FileMetaWithVersion
-	if h.verid == primitive.NilObjectID {
-		metaWVer, err = fmeta.DeleteFileMeta()
-	} else {
-		metaWVer, err = fmeta.DeleteFileMetaByVersion()
-	}
+	metaWVer, err := DeleteFile(h.user.UserID, meta.BucketId, *h.m.FileName, h.verid, h.m.GetBypassGovernance())
 	if err != nil {
+		if err == dao.ErrObjectLocked {
+			return pkt.NewErrorMsg(pkt.OBJECT_LOCKED, "Object is WORM protected and cannot be deleted")
+		}
 		return pkt.NewError(pkt.SERVER_ERROR)
 	}
 	if metaWVer == nil {
 		return pkt.NewError(pkt.INVALID_OBJECT_NAME)
 	}
-	h.AddLOG(metaWVer)
-	OBJ_DEL_LIST_CACHE.SetDefault(strconv.Itoa(int(h.user.UserID)), time.Now())
+	notifyObjectEvent(h.user, meta, pkt.EventObjectRemovedDelete, *h.m.FileName, nil, h.verid)
 	return &pkt.VoidResp{}
 }
 
-func (h *DeleteFileHandler) AddLOG(metaWVer *dao.FileMetaWithVersion) {
+func DeleteFile(uid int32, bucketId primitive.ObjectID, fileName string, verid primitive.ObjectID, bypass bool) (*dao.FileMetaWithVersion, error) {
+	var err error
+	fmeta := &dao.FileMeta{UserId: uid, BucketId: bucketId, FileName: fileName, VersionId: verid, BypassGovernance: bypass}
+	var metaWVer *dao.FileMetaWithVersion
+	if verid == primitive.NilObjectID {
+		metaWVer, err = fmeta.DeleteFileMeta()
+	} else {
+		metaWVer, err = fmeta.DeleteFileMetaByVersion()
+	}
+	if err != nil || metaWVer == nil {
+		return nil, err
+	}
 	for _, ver := range metaWVer.Version {
-		dao.AddDelLOG(h.user.UserID, ver.VersionId)
+		dao.AddDelLOG(uid, ver.VersionId)
 	}
+	releaseBucketUsage(uid, bucketId, metaWVer.Version)
+	OBJ_DEL_LIST_CACHE.SetDefault(strconv.Itoa(int(uid)), time.Now())
+	return metaWVer, nil
 }
 
 type GetObjectHandler struct {
@@ -279,8 +320,10 @@ func (h *ListObjectHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.E
 		if h.m.Limit != nil {
 			h.limit = *h.m.Limit
 		}
-		if h.limit < 10 {
+		if h.limit < 10 && h.m.Delimiter == nil {
 			h.limit = 10
+		} else if h.limit < 1 {
+			h.limit = 1
 		}
 		if h.limit > 1000 {
 			h.limit = 1000
@@ -323,9 +366,11 @@ var ListHandles = struct {
 }{handles: make(map[int32]*Handles)}
 
 func (h *ListObjectHandler) Handle() proto.Message {
-	cacheresp := h.checkCache()
-	if cacheresp != nil {
-		return cacheresp
+	byName := h.m.Delimiter != nil && !h.version
+	if !byName {
+		if cacheresp := h.checkCache(); cacheresp != nil {
+			return cacheresp
+		}
 	}
 	meta, _ := dao.GetBucketIdFromCache(*h.m.BucketName, h.user.UserID)
 	if meta == nil {
@@ -355,6 +400,9 @@ func (h *ListObjectHandler) Handle() proto.Message {
 	}
 	atomic.AddInt32(handles.cursorCount, 1)
 	defer atomic.AddInt32(handles.cursorCount, -1)
+	if byName {
+		return h.listByName(meta)
+	}
 	startTime := time.Now()
 	resp, err := dao.ListFileMeta(uint32(h.user.UserID), meta.BucketId, h.prefix, h.nextfileName, h.nextid, int64(h.limit), h.version)
 	if err != nil {
@@ -420,10 +468,7 @@ func (h *ListObjectHandler) doResponse(resp []*dao.FileMetaWithVersion) (proto.M
 		for ii, fm := range fmeta.Version {
 			i1, i2, i3, i4 := pkt.ObjectIdParam(fm.VersionId)
 			ver := &pkt.ListObjectResp_FileMetaList_VersionId{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
-			lastv := false
-			if ii >= size {
-				lastv = true
-			}
+			lastv := ii == size-1
 			m := &pkt.ListObjectResp_FileMetaList{Fileid: fid, Bucketid: bid, Versionid: ver,
 				FileName: &fmeta.FileName, Meta: fm.Meta, Acl: fm.Acl, Latest: &lastv}
 			res = append(res, m)
@@ -452,10 +497,15 @@ func (h *ListObjectHandler) doResponse(resp []*dao.FileMetaWithVersion) (proto.M
 }
 
 func (h *ListObjectHandler) addCache(resp []*pkt.ListObjectResp_FileMetaList, key string, lastline bool) proto.Message {
-	res := &pkt.ListObjectResp{Filemetalist: resp}
+	ress := compressListResp(&pkt.ListObjectResp{Filemetalist: resp}, lastline)
+	OBJ_LIST_CACHE.SetDefault(key, ress)
+	return ress
+
+}
+
+func compressListResp(res *pkt.ListObjectResp, lastline bool) proto.Message {
 	bs, err := proto.Marshal(res)
 	if err != nil {
-		OBJ_LIST_CACHE.SetDefault(key, res)
 		return res
 	}
 	buf := bytes.NewBuffer(nil)
@@ -464,7 +514,32 @@ func (h *ListObjectHandler) addCache(resp []*pkt.ListObjectResp_FileMetaList, ke
 	gw.Close()
 	ress := &pkt.ListObjectRespV2{Data: buf.Bytes()}
 	ress.Lastline = &lastline
-	OBJ_LIST_CACHE.SetDefault(key, ress)
 	return ress
+}
 
+// listByName serves name ordered listings with an optional delimiter. Pages
+// are bounded by marker and prefix rather than by cursor position, so they
+// are not cached.
+func (h *ListObjectHandler) listByName(meta *dao.BucketMeta) proto.Message {
+	startTime := time.Now()
+	files, prefixes, truncated, err := dao.ListFileMetaByName(uint32(h.user.UserID), meta.BucketId, h.prefix, h.m.GetDelimiter(), h.m.GetFileName(), int64(h.limit))
+	if err != nil {
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	ii1, ii2, ii3, ii4 := pkt.ObjectIdParam(meta.BucketId)
+	bid := &pkt.ListObjectResp_FileMetaList_BucketId{Timestamp: ii1, MachineIdentifier: ii2, ProcessIdentifier: ii3, Counter: ii4}
+	res := &pkt.ListObjectResp{CommonPrefixes: prefixes, Truncated: &truncated}
+	for _, fmeta := range files {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(fmeta.FileId)
+		fid := &pkt.ListObjectResp_FileMetaList_FileId{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+		fm := fmeta.Version[0]
+		v1, v2, v3, v4 := pkt.ObjectIdParam(fm.VersionId)
+		ver := &pkt.ListObjectResp_FileMetaList_VersionId{Timestamp: v1, MachineIdentifier: v2, ProcessIdentifier: v3, Counter: v4}
+		latest := true
+		res.Filemetalist = append(res.Filemetalist, &pkt.ListObjectResp_FileMetaList{Fileid: fid, Bucketid: bid, Versionid: ver,
+			FileName: &fmeta.FileName, Meta: fm.Meta, Acl: fm.Acl, Latest: &latest})
+	}
+	logrus.Infof("[ListObject]UID:%d,Bucket:%s,Prefix:%s,Delimiter:%s,return lines:%d,take times %d ms\n",
+		h.user.UserID, *h.m.BucketName, h.prefix, h.m.GetDelimiter(), len(files)+len(prefixes), time.Since(startTime).Milliseconds())
+	return compressListResp(res, !truncated)
 }
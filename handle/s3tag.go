@@ -0,0 +1,210 @@
+package handle
+
+import (
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/dao"
+	"github.com/yottachain/YTCoreService/pkt"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/proto"
+)
+
+func tagsFromBytes(bs []byte) ([]*dao.FileTag, error) {
+	tags := []*dao.FileTag{}
+	if len(bs) == 0 {
+		return tags, nil
+	}
+	m, err := pkt.UnmarshalMap(bs)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range m {
+		tags = append(tags, &dao.FileTag{Key: k, Value: v})
+	}
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Key < tags[j].Key })
+	return tags, nil
+}
+
+func tagsToBytes(tags []*dao.FileTag) []byte {
+	m := make(map[string]string)
+	for _, tag := range tags {
+		m[tag.Key] = tag.Value
+	}
+	bs, err := pkt.MarshalMap(m)
+	if err != nil {
+		return []byte{}
+	}
+	return bs
+}
+
+type PutObjectTaggingHandler struct {
+	pkey  string
+	m     *pkt.PutObjectTaggingReqV2
+	user  *dao.User
+	verid primitive.ObjectID
+	tags  []*dao.FileTag
+}
+
+func (h *PutObjectTaggingHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.ErrorMessage, *int32, *int32) {
+	h.pkey = pubkey
+	req, ok := msg.(*pkt.PutObjectTaggingReqV2)
+	if ok {
+		h.m = req
+		if h.m.Vnu != nil {
+			if h.m.Vnu.Timestamp == nil || h.m.Vnu.MachineIdentifier == nil || h.m.Vnu.ProcessIdentifier == nil || h.m.Vnu.Counter == nil {
+				return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+			}
+			h.verid = pkt.NewObjectId(*h.m.Vnu.Timestamp, *h.m.Vnu.MachineIdentifier, *h.m.Vnu.ProcessIdentifier, *h.m.Vnu.Counter)
+		}
+		if h.m.UserId == nil || h.m.SignData == nil || h.m.KeyNumber == nil || h.m.BucketName == nil || h.m.FileName == nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+		}
+		tags, err := tagsFromBytes(h.m.Tags)
+		if err != nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Tags"), nil, nil
+		}
+		h.tags = tags
+		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
+		if h.user == nil {
+			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
+		}
+		return nil, WRITE_ROUTINE_NUM, nil
+	} else {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request"), nil, nil
+	}
+}
+
+func (h *PutObjectTaggingHandler) Handle() proto.Message {
+	logrus.Infof("[PutObjectTagging]UID:%d,BucketName:%s,FileName:%s,tags:%d\n", h.user.UserID, *h.m.BucketName, *h.m.FileName, len(h.tags))
+	meta, _ := dao.GetBucketIdFromCache(*h.m.BucketName, h.user.UserID)
+	if meta == nil {
+		return pkt.NewError(pkt.INVALID_BUCKET_NAME)
+	}
+	fmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: meta.BucketId, FileName: *h.m.FileName, VersionId: h.verid, Tags: h.tags}
+	err := fmeta.UpdateFileTags()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return pkt.NewError(pkt.INVALID_OBJECT_NAME)
+		}
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	return &pkt.VoidResp{}
+}
+
+type GetObjectTaggingHandler struct {
+	pkey  string
+	m     *pkt.GetObjectTaggingReqV2
+	user  *dao.User
+	verid primitive.ObjectID
+}
+
+func (h *GetObjectTaggingHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.ErrorMessage, *int32, *int32) {
+	h.pkey = pubkey
+	req, ok := msg.(*pkt.GetObjectTaggingReqV2)
+	if ok {
+		h.m = req
+		if h.m.Vnu != nil {
+			if h.m.Vnu.Timestamp == nil || h.m.Vnu.MachineIdentifier == nil || h.m.Vnu.ProcessIdentifier == nil || h.m.Vnu.Counter == nil {
+				return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+			}
+			h.verid = pkt.NewObjectId(*h.m.Vnu.Timestamp, *h.m.Vnu.MachineIdentifier, *h.m.Vnu.ProcessIdentifier, *h.m.Vnu.Counter)
+		}
+		if h.m.UserId == nil || h.m.SignData == nil || h.m.KeyNumber == nil || h.m.BucketName == nil || h.m.FileName == nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+		}
+		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
+		if h.user == nil {
+			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
+		}
+		return nil, READ_ROUTINE_NUM, h.user.Routine
+	} else {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request"), nil, nil
+	}
+}
+
+func (h *GetObjectTaggingHandler) Handle() proto.Message {
+	logrus.Infof("[GetObjectTagging]UID:%d,BucketName:%s,FileName:%s\n", h.user.UserID, *h.m.BucketName, *h.m.FileName)
+	meta, _ := dao.GetBucketIdFromCache(*h.m.BucketName, h.user.UserID)
+	if meta == nil {
+		return pkt.NewError(pkt.INVALID_BUCKET_NAME)
+	}
+	fmeta := &dao.FileMeta{UserId: h.user.UserID, BucketId: meta.BucketId, FileName: *h.m.FileName, VersionId: h.verid}
+	err := fmeta.GetFileTags()
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return pkt.NewError(pkt.INVALID_OBJECT_NAME)
+		}
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	return &pkt.GetObjectTaggingResp{Tags: tagsToBytes(fmeta.Tags)}
+}
+
+type ListObjectByTagHandler struct {
+	pkey  string
+	m     *pkt.ListObjectByTagReqV2
+	user  *dao.User
+	limit uint32
+}
+
+func (h *ListObjectByTagHandler) SetMessage(pubkey string, msg proto.Message) (*pkt.ErrorMessage, *int32, *int32) {
+	h.pkey = pubkey
+	req, ok := msg.(*pkt.ListObjectByTagReqV2)
+	if ok {
+		h.m = req
+		if h.m.UserId == nil || h.m.SignData == nil || h.m.KeyNumber == nil || h.m.BucketName == nil || h.m.TagKey == nil {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:Null value"), nil, nil
+		}
+		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
+		if h.user == nil {
+			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
+		}
+		if h.m.Limit != nil {
+			h.limit = *h.m.Limit
+		}
+		if h.limit < 10 {
+			h.limit = 10
+		}
+		if h.limit > 1000 {
+			h.limit = 1000
+		}
+		return nil, READ_ROUTINE_NUM, h.user.Routine
+	} else {
+		return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request"), nil, nil
+	}
+}
+
+func (h *ListObjectByTagHandler) Handle() proto.Message {
+	meta, _ := dao.GetBucketIdFromCache(*h.m.BucketName, h.user.UserID)
+	if meta == nil {
+		return pkt.NewError(pkt.INVALID_BUCKET_NAME)
+	}
+	value, startId := "", primitive.NilObjectID
+	if h.m.TagValue != nil {
+		value = *h.m.TagValue
+	}
+	if id := h.m.Startid; id != nil {
+		startId = pkt.NewObjectId(id.GetTimestamp(), id.GetMachineIdentifier(), id.GetProcessIdentifier(), id.GetCounter())
+	}
+	resp, err := dao.ListFileMetaByTag(uint32(h.user.UserID), meta.BucketId, *h.m.TagKey, value, startId, int64(h.limit))
+	if err != nil {
+		return pkt.NewError(pkt.SERVER_ERROR)
+	}
+	logrus.Infof("[ListObjectByTag]UID:%d,Bucket:%s,Tag:%s=%s,return lines:%d\n", h.user.UserID, *h.m.BucketName, *h.m.TagKey, value, len(resp))
+	res := []*pkt.ListObjectResp_FileMetaList{}
+	latest := true
+	for _, fmeta := range resp {
+		i1, i2, i3, i4 := pkt.ObjectIdParam(fmeta.FileId)
+		fid := &pkt.ListObjectResp_FileMetaList_FileId{Timestamp: i1, MachineIdentifier: i2, ProcessIdentifier: i3, Counter: i4}
+		ii1, ii2, ii3, ii4 := pkt.ObjectIdParam(fmeta.BucketId)
+		bid := &pkt.ListObjectResp_FileMetaList_BucketId{Timestamp: ii1, MachineIdentifier: ii2, ProcessIdentifier: ii3, Counter: ii4}
+		fm := fmeta.Version[0]
+		v1, v2, v3, v4 := pkt.ObjectIdParam(fm.VersionId)
+		ver := &pkt.ListObjectResp_FileMetaList_VersionId{Timestamp: v1, MachineIdentifier: v2, ProcessIdentifier: v3, Counter: v4}
+		name := fmeta.FileName
+		res = append(res, &pkt.ListObjectResp_FileMetaList{Fileid: fid, Bucketid: bid, Versionid: ver,
+			FileName: &name, Meta: fm.Meta, Acl: fm.Acl, Latest: &latest})
+	}
+	return &pkt.ListObjectResp{Filemetalist: res}
+}
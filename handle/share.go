@@ -280,6 +280,9 @@ func (h *GetFileMetaHandler) Handle() proto.Message {
 	if err != nil {
 		return pkt.NewError(pkt.INVALID_OBJECT_NAME)
 	}
+	if errmsg := checkCustomerKey(meta, h.m.SseKeyMD5); errmsg != nil {
+		return errmsg
+	}
 	size := uint32(len(meta.BlockList))
 	pkt.ToOldBlockList(meta.BlockList)
 	refs := &pkt.GetFileAuthResp_RefList{Count: &size, Refers: meta.BlockList}
@@ -333,7 +336,8 @@ func (h *UploadBlockAuthHandler) Handle() proto.Message {
 		vnf = uint8(meta.VNF)
 	}
 	ref := &pkt.Refer{VBI: meta.VBI, Dup: 1, ShdCount: vnf, OriginalSize: h.ref.OriginalSize,
-		RealSize: h.ref.RealSize, KEU: h.ref.KEU, KeyNumber: h.ref.KeyNumber, Id: h.ref.Id}
+		RealSize: h.ref.RealSize, KEU: h.ref.KEU, KeyNumber: h.ref.KeyNumber, Id: h.ref.Id,
+		Format: codec.ReferFormat(meta.KED)}
 	perr := SaveObjectMeta(h.user, ref, h.vnu)
 	if perr != nil {
 		return perr
@@ -109,16 +109,18 @@ func CheckBlockDup(vhp []byte) proto.Message {
 		vhbs := make([][]byte, size)
 		keds := make([][]byte, size)
 		ars := make([]int32, size)
+		rss := make([]int32, size)
 		for index, m := range ls {
 			vhbs[index] = m.VHB
 			keds[index] = m.KED
 			ars[index] = int32(m.AR)
+			rss[index] = m.RS
 		}
 		count := uint32(size)
 		pbvhbs := &pkt.UploadBlockDupResp_VHBS{Count: &count, VHB: vhbs}
 		pbkeds := &pkt.UploadBlockDupResp_KEDS{Count: &count, KED: keds}
 		pbars := &pkt.UploadBlockDupResp_ARS{Count: &count, AR: ars}
-		return &pkt.UploadBlockDupResp{StartTime: &st, Vhbs: pbvhbs, Keds: pbkeds, Ars: pbars}
+		return &pkt.UploadBlockDupResp{StartTime: &st, Vhbs: pbvhbs, Keds: pbkeds, Ars: pbars, RealSize: rss}
 	}
 }
 
@@ -156,7 +158,7 @@ func (h *UploadBlockDBHandler) SetMessage(pubkey string, msg proto.Message) (*pk
 		if h.m.KEU == nil || len(h.m.KEU) != 32 {
 			return pkt.NewError(pkt.INVALID_KEU), nil, nil
 		}
-		if h.m.KED == nil || len(h.m.KED) != 32 {
+		if codec.KEDBlockFormat(h.m.KED) == 0 {
 			return pkt.NewError(pkt.INVALID_KED), nil, nil
 		}
 		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
@@ -200,7 +202,8 @@ func (h *UploadBlockDBHandler) Handle() proto.Message {
 		}
 	}
 	ref := &pkt.Refer{VBI: vbi, Dup: 0, ShdCount: 0, OriginalSize: int64(*h.m.OriginalSize),
-		RealSize: int32(len(h.m.Data)), KEU: h.m.KEU, KeyNumber: int16(h.storeNumber), Id: int16(*h.m.Id)}
+		RealSize: int32(len(h.m.Data)), KEU: h.m.KEU, KeyNumber: int16(h.storeNumber), Id: int16(*h.m.Id),
+		Format: codec.ReferFormat(meta.KED)}
 	perr := SaveObjectMeta(h.user, ref, h.vnu)
 	if perr != nil {
 		return perr
@@ -264,7 +267,8 @@ func (h *UploadBlockDupHandler) Handle() proto.Message {
 		vnf = uint8(meta.VNF)
 	}
 	ref := &pkt.Refer{VBI: meta.VBI, Dup: 1, ShdCount: vnf, OriginalSize: int64(*h.m.OriginalSize),
-		RealSize: int32(*h.m.RealSize), KEU: h.m.KEU, KeyNumber: int16(h.storeNumber), Id: int16(*h.m.Id)}
+		RealSize: int32(*h.m.RealSize), KEU: h.m.KEU, KeyNumber: int16(h.storeNumber), Id: int16(*h.m.Id),
+		Format: codec.ReferFormat(meta.KED)}
 	perr := SaveObjectMeta(h.user, ref, h.vnu)
 	if perr != nil {
 		return perr
@@ -321,7 +325,7 @@ func (h *UploadBlockEndHandler) SetMessage(pubkey string, msg proto.Message) (*p
 		if h.m.KEU == nil || len(h.m.KEU) != 32 {
 			return pkt.NewError(pkt.INVALID_KEU), nil, nil
 		}
-		if h.m.KED == nil || len(h.m.KED) != 32 {
+		if codec.KEDBlockFormat(h.m.KED) == 0 {
 			return pkt.NewError(pkt.INVALID_KED), nil, nil
 		}
 		if h.m.Vnu == nil || h.m.Id == nil || h.m.OriginalSize == nil || h.m.RealSize == nil || h.m.AR == nil {
@@ -387,11 +391,12 @@ func (h *UploadBlockEndHandler) Handle() proto.Message {
 	}
 	if meta == nil {
 		meta = &dao.BlockMeta{VBI: vbi, VHP: h.m.VHP, VHB: h.m.VHB, KED: h.m.KED,
-			VNF: int16(shardcount), NLINK: 1, AR: int16(*h.m.AR)}
+			VNF: int16(shardcount), NLINK: 1, AR: int16(*h.m.AR), RS: int32(h.m.GetRealSize())}
 		dao.SaveBlockMeta(meta)
 	}
 	ref := &pkt.Refer{VBI: meta.VBI, Dup: 0, ShdCount: uint8(shardcount), OriginalSize: *h.m.OriginalSize,
-		RealSize: *h.m.RealSize, KEU: h.m.KEU, KeyNumber: int16(h.storeNumber), Id: int16(*h.m.Id)}
+		RealSize: *h.m.RealSize, KEU: h.m.KEU, KeyNumber: int16(h.storeNumber), Id: int16(*h.m.Id),
+		Format: codec.ReferFormat(meta.KED)}
 	perr := SaveObjectMeta(h.user, ref, h.vnu)
 	if perr != nil {
 		return perr
@@ -521,7 +526,7 @@ func (h *UploadBlockEndV3Handler) SetMessage(pubkey string, msg proto.Message) (
 		if h.m.KEU == nil || len(h.m.KEU) != 32 {
 			return pkt.NewError(pkt.INVALID_KEU), nil, nil
 		}
-		if h.m.KED == nil || len(h.m.KED) != 32 {
+		if codec.KEDBlockFormat(h.m.KED) == 0 {
 			return pkt.NewError(pkt.INVALID_KED), nil, nil
 		}
 		if h.m.VNU == nil || h.m.Id == nil || h.m.OriginalSize == nil || h.m.RealSize == nil || h.m.AR == nil {
@@ -600,11 +605,12 @@ func (h *UploadBlockEndV3Handler) Handle() proto.Message {
 	}
 	if meta == nil {
 		meta = &dao.BlockMeta{VBI: vbi, VHP: h.m.VHP, VHB: h.m.VHB, KED: h.m.KED,
-			VNF: int16(shardcount), NLINK: 1, AR: int16(*h.m.AR)}
+			VNF: int16(shardcount), NLINK: 1, AR: int16(*h.m.AR), RS: int32(h.m.GetRealSize())}
 		dao.SaveBlockMeta(meta)
 	}
 	ref := &pkt.Refer{VBI: meta.VBI, Dup: 0, ShdCount: uint8(shardcount), OriginalSize: *h.m.OriginalSize,
-		RealSize: *h.m.RealSize, KEU: h.m.KEU, KeyNumber: int16(h.storeNumber), Id: int16(*h.m.Id)}
+		RealSize: *h.m.RealSize, KEU: h.m.KEU, KeyNumber: int16(h.storeNumber), Id: int16(*h.m.Id),
+		Format: codec.ReferFormat(meta.KED)}
 	perr := SaveObjectMeta(h.user, ref, h.vnu)
 	if perr != nil {
 		return perr
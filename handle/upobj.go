@@ -33,6 +33,9 @@ func (h *UploadObjectInitHandler) SetMessage(pubkey string, msg proto.Message) (
 		if len(h.m.VHW) != 32 {
 			return pkt.NewError(pkt.INVALID_VHW), nil, nil
 		}
+		if len(h.m.SseKeyMD5) != 0 && len(h.m.SseKeyMD5) != 16 {
+			return pkt.NewErrorMsg(pkt.INVALID_ARGS, "Invalid request:sseKeyMD5"), nil, nil
+		}
 		h.user = dao.GetUserCache(int32(*h.m.UserId), int(*h.m.KeyNumber), *h.m.SignData)
 		if h.user == nil {
 			return pkt.NewError(pkt.INVALID_SIGNATURE), nil, nil
@@ -48,6 +51,11 @@ func (h *UploadObjectInitHandler) Handle() proto.Message {
 	if len(h.m.VHW) != 32 {
 		return pkt.NewError(pkt.INVALID_VHW)
 	}
+	if h.m.BucketName != nil {
+		if errmsg := CheckBucketQuota(h.user.UserID, *h.m.BucketName, int64(*h.m.Length)); errmsg != nil {
+			return errmsg
+		}
+	}
 	flag, err := eos.CheckFreeSpace(h.user.UserID)
 	if err != nil {
 		logrus.Errorf("[UploadOBJInit][%d]CheckFreeSpace ERR:%s\n", h.user.UserID, err)
@@ -98,6 +106,7 @@ func (h *UploadObjectInitHandler) Handle() proto.Message {
 		meta.NLINK = 0
 		meta.Usedspace = 0
 		meta.BlockList = [][]byte{}
+		meta.SSE = h.m.SseKeyMD5
 		err = meta.Insert()
 		if err != nil {
 			return pkt.NewError(pkt.SERVER_ERROR)
@@ -190,6 +199,13 @@ func (h *UploadObjectEndHandler) SumUsedSpace(meta *dao.ObjectMeta) uint64 {
 func (h *UploadObjectEndHandler) Handle() proto.Message {
 	meta := dao.NewObjectMeta(h.user.UserID, h.m.VHW)
 	exists, err := meta.IsExists()
+	if err == nil && !exists && len(h.m.FinalVHW) == 32 {
+		meta = dao.NewObjectMeta(h.user.UserID, h.m.FinalVHW)
+		exists, err = meta.IsExists()
+		if exists && meta.VNU != h.vnu {
+			exists = false
+		}
+	}
 	if err != nil || !exists {
 		return pkt.NewError(pkt.SERVER_ERROR)
 	}
@@ -197,6 +213,15 @@ func (h *UploadObjectEndHandler) Handle() proto.Message {
 		logrus.Warnf("[UploadOBJEnd][%s]Already completed.\n", h.vnu.Hex())
 		return pkt.NewError(pkt.INVALID_UPLOAD_ID)
 	}
+	if len(h.m.FinalVHW) == 32 && !bytes.Equal(meta.VHW, h.m.FinalVHW) {
+		moved, err := meta.Rekey(h.m.FinalVHW)
+		if err != nil {
+			return pkt.NewError(pkt.SERVER_ERROR)
+		}
+		if !moved {
+			logrus.Warnf("[UploadOBJEnd][%s]VHW already exists,keep provisional VHW.\n", h.vnu.Hex())
+		}
+	}
 	usedspace := h.SumUsedSpace(meta)
 	if usedspace == 0 {
 		logrus.Warnf("[UploadOBJEnd][%s]Zero length file.\n", h.vnu.Hex())
@@ -52,6 +52,9 @@ func init_id_class() {
 	ID_CLASS_MAP[0x67fc]=func() proto.Message { return &CopyObjectResp{} }
 	ID_CLASS_MAP[0x43f2]=func() proto.Message { return &GetBucketResp{} }
 	ID_CLASS_MAP[0x85a7]=func() proto.Message { return &GetObjectResp{} }
+	ID_CLASS_MAP[0xb4d2]=func() proto.Message { return &GetObjectTaggingResp{} }
+	ID_CLASS_MAP[0xd84a]=func() proto.Message { return &GetObjectAclResp{} }
+	ID_CLASS_MAP[0x91b5]=func() proto.Message { return &GetObjectLockResp{} }
 	ID_CLASS_MAP[0xc090]=func() proto.Message { return &ListBucketResp{} }
 	ID_CLASS_MAP[0x06c5]=func() proto.Message { return &ListObjectResp{} }
 	ID_CLASS_MAP[0x276d]=func() proto.Message { return &ListObjectRespV2{} }
@@ -64,7 +67,16 @@ func init_id_class() {
 	ID_CLASS_MAP[0x0d8e]=func() proto.Message { return &GetObjectReqV2{} }
 	ID_CLASS_MAP[0xfd39]=func() proto.Message { return &ListBucketReqV2{} }
 	ID_CLASS_MAP[0xc23f]=func() proto.Message { return &ListObjectReqV2{} }
+	ID_CLASS_MAP[0x5a3e]=func() proto.Message { return &GetObjectTaggingReqV2{} }
+	ID_CLASS_MAP[0x9c71]=func() proto.Message { return &PutObjectTaggingReqV2{} }
+	ID_CLASS_MAP[0x2f86]=func() proto.Message { return &ListObjectByTagReqV2{} }
+	ID_CLASS_MAP[0x6e1b]=func() proto.Message { return &GetObjectAclReqV2{} }
+	ID_CLASS_MAP[0x3c58]=func() proto.Message { return &PutObjectAclReqV2{} }
+	ID_CLASS_MAP[0x7a19]=func() proto.Message { return &GetObjectLockReqV2{} }
+	ID_CLASS_MAP[0x4e63]=func() proto.Message { return &PutObjectRetentionReqV2{} }
+	ID_CLASS_MAP[0xc1d7]=func() proto.Message { return &PutObjectLegalHoldReqV2{} }
 	ID_CLASS_MAP[0xde6c]=func() proto.Message { return &UpdateBucketReqV2{} }
+	ID_CLASS_MAP[0x8d35]=func() proto.Message { return &SetBucketMetaReqV2{} }
 	ID_CLASS_MAP[0x48bf]=func() proto.Message { return &UploadFileReqV2{} }
 	ID_CLASS_MAP[0x775e]=func() proto.Message { return &ActiveCacheV2{} }
 	ID_CLASS_MAP[0xe66e]=func() proto.Message { return &DownloadBlockInitReqV2{} }
@@ -120,6 +132,9 @@ func init_class_id() {
 	CLASS_ID_MAP["CopyObjectResp"]=0x67fc
 	CLASS_ID_MAP["GetBucketResp"]=0x43f2
 	CLASS_ID_MAP["GetObjectResp"]=0x85a7
+	CLASS_ID_MAP["GetObjectTaggingResp"]=0xb4d2
+	CLASS_ID_MAP["GetObjectAclResp"]=0xd84a
+	CLASS_ID_MAP["GetObjectLockResp"]=0x91b5
 	CLASS_ID_MAP["ListBucketResp"]=0xc090
 	CLASS_ID_MAP["ListObjectResp"]=0x06c5
 	CLASS_ID_MAP["ListObjectRespV2"]=0x276d
@@ -132,7 +147,16 @@ func init_class_id() {
 	CLASS_ID_MAP["GetObjectReqV2"]=0x0d8e
 	CLASS_ID_MAP["ListBucketReqV2"]=0xfd39
 	CLASS_ID_MAP["ListObjectReqV2"]=0xc23f
+	CLASS_ID_MAP["GetObjectTaggingReqV2"]=0x5a3e
+	CLASS_ID_MAP["PutObjectTaggingReqV2"]=0x9c71
+	CLASS_ID_MAP["ListObjectByTagReqV2"]=0x2f86
+	CLASS_ID_MAP["GetObjectAclReqV2"]=0x6e1b
+	CLASS_ID_MAP["PutObjectAclReqV2"]=0x3c58
+	CLASS_ID_MAP["GetObjectLockReqV2"]=0x7a19
+	CLASS_ID_MAP["PutObjectRetentionReqV2"]=0x4e63
+	CLASS_ID_MAP["PutObjectLegalHoldReqV2"]=0xc1d7
 	CLASS_ID_MAP["UpdateBucketReqV2"]=0xde6c
+	CLASS_ID_MAP["SetBucketMetaReqV2"]=0x8d35
 	CLASS_ID_MAP["UploadFileReqV2"]=0x48bf
 	CLASS_ID_MAP["ActiveCacheV2"]=0x775e
 	CLASS_ID_MAP["DownloadBlockInitReqV2"]=0xe66e
@@ -48,6 +48,9 @@ const CODEC_ERROR = 0x32
 const BAD_FILE = 0x33
 const PRIKEY_NOT_EXIST = 0x34
 const REPEAT_REQ = 0x35
+const BUCKET_QUOTA_EXCEEDED = 0x36
+const OBJECT_LOCKED = 0x37
+const CUSTOMER_KEY_REQUIRED = 0x38
 
 var BUSY_ERROR = NewErrorMsg(SERVER_ERROR, "Too many routines")
 
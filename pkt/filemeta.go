@@ -0,0 +1,49 @@
+package pkt
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Keys of the file meta map shared by the client and the SN.
+const (
+	FileMetaLengthKey = "contentLength"
+	FileMetaETagKey   = "ETag"
+	FileMetaDateKey   = "x-amz-date"
+)
+
+// FileMetaToMap returns file meta as a map, expanding the legacy length+md5
+// bytes of older versions.
+func FileMetaToMap(meta []byte, versionid primitive.ObjectID) (map[string]string, error) {
+	if meta == nil {
+		return nil, errors.New("no data")
+	}
+	if len(meta) < 24 {
+		return nil, errors.New("err data")
+	}
+	if m, err := UnmarshalMap(meta); err == nil && m[FileMetaETagKey] != "" {
+		return m, nil
+	}
+	m := make(map[string]string)
+	m[FileMetaLengthKey] = strconv.FormatInt(int64(binary.BigEndian.Uint64(meta)), 10)
+	m[FileMetaETagKey] = hex.EncodeToString(meta[8:])
+	if versionid != primitive.NilObjectID {
+		m[FileMetaDateKey] = versionid.Timestamp().String()
+	}
+	return m, nil
+}
+
+// FileMetaLength returns the object length recorded in file meta, 0 if it
+// can not be decoded.
+func FileMetaLength(meta []byte) int64 {
+	m, err := FileMetaToMap(meta, primitive.NilObjectID)
+	if err != nil {
+		return 0
+	}
+	n, _ := strconv.ParseInt(m[FileMetaLengthKey], 10, 64)
+	return n
+}
@@ -0,0 +1,115 @@
+package pkt
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const BucketMetaLifecycle = "lifecycle"
+
+// LifecycleConfiguration is the lifecycle of a bucket as kept in its meta
+// and applied by the SN. The gateway converts it from the S3 XML document.
+type LifecycleConfiguration struct {
+	Rules []*LifecycleRule `json:"rules"`
+}
+
+type LifecycleRule struct {
+	ID                             string                          `json:"id,omitempty"`
+	Status                         string                          `json:"status"`
+	Prefix                         *string                         `json:"prefix,omitempty"`
+	Filter                         *LifecycleFilter                `json:"filter,omitempty"`
+	Expiration                     *LifecycleExpiration            `json:"expiration,omitempty"`
+	NoncurrentVersionExpiration    *NoncurrentVersionExpiration    `json:"noncurrentVersionExpiration,omitempty"`
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `json:"abortIncompleteMultipartUpload,omitempty"`
+}
+
+type LifecycleFilter struct {
+	Prefix string `json:"prefix"`
+}
+
+type LifecycleExpiration struct {
+	Days int    `json:"days,omitempty"`
+	Date string `json:"date,omitempty"`
+}
+
+type NoncurrentVersionExpiration struct {
+	NoncurrentDays          int `json:"noncurrentDays,omitempty"`
+	NewerNoncurrentVersions int `json:"newerNoncurrentVersions,omitempty"`
+}
+
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `json:"daysAfterInitiation"`
+}
+
+func ParseLifecycle(data []byte) (*LifecycleConfiguration, error) {
+	conf := &LifecycleConfiguration{}
+	if err := json.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func (c *LifecycleConfiguration) Marshal() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+func (r *LifecycleRule) Enabled() bool {
+	return r.Status == "Enabled"
+}
+
+func (r *LifecycleRule) KeyPrefix() string {
+	if r.Filter != nil {
+		return r.Filter.Prefix
+	}
+	if r.Prefix != nil {
+		return *r.Prefix
+	}
+	return ""
+}
+
+func (r *LifecycleRule) Matches(key string) bool {
+	return r.Enabled() && strings.HasPrefix(key, r.KeyPrefix())
+}
+
+func (r *LifecycleRule) Expired(created, now time.Time) bool {
+	e := r.Expiration
+	if e == nil {
+		return false
+	}
+	if e.Date != "" {
+		t, err := time.Parse(time.RFC3339, e.Date)
+		return err == nil && !now.Before(t)
+	}
+	if e.Days <= 0 {
+		return false
+	}
+	return now.Sub(created) >= time.Duration(e.Days)*24*time.Hour
+}
+
+// NoncurrentExpired reports whether a noncurrent version should be removed.
+// newer is the number of noncurrent versions newer than this one, and since
+// is when the version became noncurrent.
+func (r *LifecycleRule) NoncurrentExpired(newer int, since, now time.Time) bool {
+	n := r.NoncurrentVersionExpiration
+	if n == nil || n.NoncurrentDays <= 0 && n.NewerNoncurrentVersions <= 0 || newer < n.NewerNoncurrentVersions {
+		return false
+	}
+	return now.Sub(since) >= time.Duration(n.NoncurrentDays)*24*time.Hour
+}
+
+// AbortDays returns the fewest days after which a matching rule aborts an
+// incomplete multipart upload of key, 0 if none applies.
+func (c *LifecycleConfiguration) AbortDays(key string) int {
+	days := 0
+	for _, rule := range c.Rules {
+		a := rule.AbortIncompleteMultipartUpload
+		if a == nil || a.DaysAfterInitiation <= 0 || !rule.Matches(key) {
+			continue
+		}
+		if days == 0 || a.DaysAfterInitiation < days {
+			days = a.DaysAfterInitiation
+		}
+	}
+	return days
+}
@@ -0,0 +1,80 @@
+package pkt
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLifecycleRuleExpired(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	tests := []struct {
+		name       string
+		expiration *LifecycleExpiration
+		age        time.Duration
+		expired    bool
+	}{
+		{"none", nil, 1000 * day, false},
+		{"days", &LifecycleExpiration{Days: 30}, 31 * day, true},
+		{"days not yet", &LifecycleExpiration{Days: 30}, 29 * day, false},
+		{"zero days", &LifecycleExpiration{}, 1000 * day, false},
+		{"negative days", &LifecycleExpiration{Days: -1}, 1000 * day, false},
+		{"date passed", &LifecycleExpiration{Date: "2026-05-01T00:00:00Z"}, 0, true},
+		{"date ahead", &LifecycleExpiration{Date: "2026-07-01T00:00:00Z"}, 1000 * day, false},
+		{"bad date", &LifecycleExpiration{Date: "yesterday"}, 1000 * day, false},
+	}
+	for _, tt := range tests {
+		r := &LifecycleRule{Status: "Enabled", Expiration: tt.expiration}
+		if got := r.Expired(now.Add(-tt.age), now); got != tt.expired {
+			t.Errorf("%s: expired = %v", tt.name, got)
+		}
+	}
+}
+
+func TestLifecycleRuleNoncurrentExpired(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	tests := []struct {
+		name    string
+		n       *NoncurrentVersionExpiration
+		newer   int
+		age     time.Duration
+		expired bool
+	}{
+		{"none", nil, 5, 1000 * day, false},
+		{"empty", &NoncurrentVersionExpiration{}, 5, 1000 * day, false},
+		{"days", &NoncurrentVersionExpiration{NoncurrentDays: 7}, 0, 8 * day, true},
+		{"days not yet", &NoncurrentVersionExpiration{NoncurrentDays: 7}, 0, 6 * day, false},
+		{"keep newer", &NoncurrentVersionExpiration{NewerNoncurrentVersions: 2}, 1, 1000 * day, false},
+		{"beyond newer", &NoncurrentVersionExpiration{NewerNoncurrentVersions: 2}, 2, 0, true},
+	}
+	for _, tt := range tests {
+		r := &LifecycleRule{Status: "Enabled", NoncurrentVersionExpiration: tt.n}
+		if got := r.NoncurrentExpired(tt.newer, now.Add(-tt.age), now); got != tt.expired {
+			t.Errorf("%s: expired = %v", tt.name, got)
+		}
+	}
+}
+
+func TestLifecycleMatches(t *testing.T) {
+	logs, tmp := "logs/", "tmp/"
+	conf := &LifecycleConfiguration{Rules: []*LifecycleRule{
+		{Status: "Enabled", Prefix: &logs, AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: 7}},
+		{Status: "Enabled", Filter: &LifecycleFilter{Prefix: "logs/2026"}, AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: 3}},
+		{Status: "Disabled", Prefix: &tmp, AbortIncompleteMultipartUpload: &AbortIncompleteMultipartUpload{DaysAfterInitiation: 1}},
+	}}
+	tests := []struct {
+		key  string
+		days int
+	}{
+		{"logs/a", 7},
+		{"logs/2026/a", 3},
+		{"tmp/a", 0},
+		{"data/a", 0},
+	}
+	for _, tt := range tests {
+		if got := conf.AbortDays(tt.key); got != tt.days {
+			t.Errorf("AbortDays(%q) = %d, expected %d", tt.key, got, tt.days)
+		}
+	}
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.21.0
+// 	protoc-gen-go v1.25.0
 // 	protoc        v3.21.2
 // source: msg.s3.proto
 
@@ -290,7 +290,9 @@ type ListObjectResp struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filemetalist []*ListObjectResp_FileMetaList `protobuf:"group,1,rep,name=FileMetaList,json=filemetalist" json:"filemetalist,omitempty"`
+	Filemetalist   []*ListObjectResp_FileMetaList `protobuf:"group,1,rep,name=FileMetaList,json=filemetalist" json:"filemetalist,omitempty"`
+	CommonPrefixes []string                       `protobuf:"bytes,2,rep,name=commonPrefixes" json:"commonPrefixes,omitempty"`
+	Truncated      *bool                          `protobuf:"varint,3,opt,name=truncated" json:"truncated,omitempty"`
 }
 
 func (x *ListObjectResp) Reset() {
@@ -332,6 +334,20 @@ func (x *ListObjectResp) GetFilemetalist() []*ListObjectResp_FileMetaList {
 	return nil
 }
 
+func (x *ListObjectResp) GetCommonPrefixes() []string {
+	if x != nil {
+		return x.CommonPrefixes
+	}
+	return nil
+}
+
+func (x *ListObjectResp) GetTruncated() bool {
+	if x != nil && x.Truncated != nil {
+		return *x.Truncated
+	}
+	return false
+}
+
 type ListObjectRespV2 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -442,6 +458,163 @@ func (x *StringMap) GetVals() []*StringMap_Vals {
 	return nil
 }
 
+type GetObjectTaggingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []byte `protobuf:"bytes,1,opt,name=tags" json:"tags,omitempty"`
+}
+
+func (x *GetObjectTaggingResp) Reset() {
+	*x = GetObjectTaggingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectTaggingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectTaggingResp) ProtoMessage() {}
+
+func (x *GetObjectTaggingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectTaggingResp.ProtoReflect.Descriptor instead.
+func (*GetObjectTaggingResp) Descriptor() ([]byte, []int) {
+	return file_msg_s3_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetObjectTaggingResp) GetTags() []byte {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type GetObjectAclResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Acl []byte `protobuf:"bytes,1,opt,name=acl" json:"acl,omitempty"`
+}
+
+func (x *GetObjectAclResp) Reset() {
+	*x = GetObjectAclResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectAclResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectAclResp) ProtoMessage() {}
+
+func (x *GetObjectAclResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectAclResp.ProtoReflect.Descriptor instead.
+func (*GetObjectAclResp) Descriptor() ([]byte, []int) {
+	return file_msg_s3_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetObjectAclResp) GetAcl() []byte {
+	if x != nil {
+		return x.Acl
+	}
+	return nil
+}
+
+type GetObjectLockResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode        *string `protobuf:"bytes,1,opt,name=mode" json:"mode,omitempty"`
+	RetainUntil *int64  `protobuf:"varint,2,opt,name=retainUntil" json:"retainUntil,omitempty"`
+	LegalHold   *bool   `protobuf:"varint,3,opt,name=legalHold" json:"legalHold,omitempty"`
+}
+
+func (x *GetObjectLockResp) Reset() {
+	*x = GetObjectLockResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectLockResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectLockResp) ProtoMessage() {}
+
+func (x *GetObjectLockResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectLockResp.ProtoReflect.Descriptor instead.
+func (*GetObjectLockResp) Descriptor() ([]byte, []int) {
+	return file_msg_s3_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetObjectLockResp) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *GetObjectLockResp) GetRetainUntil() int64 {
+	if x != nil && x.RetainUntil != nil {
+		return *x.RetainUntil
+	}
+	return 0
+}
+
+func (x *GetObjectLockResp) GetLegalHold() bool {
+	if x != nil && x.LegalHold != nil {
+		return *x.LegalHold
+	}
+	return false
+}
+
 type CopyObjectResp_BucketId struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -456,7 +629,7 @@ type CopyObjectResp_BucketId struct {
 func (x *CopyObjectResp_BucketId) Reset() {
 	*x = CopyObjectResp_BucketId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[7]
+		mi := &file_msg_s3_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -469,7 +642,7 @@ func (x *CopyObjectResp_BucketId) String() string {
 func (*CopyObjectResp_BucketId) ProtoMessage() {}
 
 func (x *CopyObjectResp_BucketId) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[7]
+	mi := &file_msg_s3_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +700,7 @@ type CopyObjectResp_VersionId struct {
 func (x *CopyObjectResp_VersionId) Reset() {
 	*x = CopyObjectResp_VersionId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[8]
+		mi := &file_msg_s3_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -540,7 +713,7 @@ func (x *CopyObjectResp_VersionId) String() string {
 func (*CopyObjectResp_VersionId) ProtoMessage() {}
 
 func (x *CopyObjectResp_VersionId) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[8]
+	mi := &file_msg_s3_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -598,7 +771,7 @@ type CopyObjectResp_FileId struct {
 func (x *CopyObjectResp_FileId) Reset() {
 	*x = CopyObjectResp_FileId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[9]
+		mi := &file_msg_s3_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -611,7 +784,7 @@ func (x *CopyObjectResp_FileId) String() string {
 func (*CopyObjectResp_FileId) ProtoMessage() {}
 
 func (x *CopyObjectResp_FileId) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[9]
+	mi := &file_msg_s3_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +842,7 @@ type GetObjectResp_Id struct {
 func (x *GetObjectResp_Id) Reset() {
 	*x = GetObjectResp_Id{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[10]
+		mi := &file_msg_s3_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -682,7 +855,7 @@ func (x *GetObjectResp_Id) String() string {
 func (*GetObjectResp_Id) ProtoMessage() {}
 
 func (x *GetObjectResp_Id) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[10]
+	mi := &file_msg_s3_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,7 +911,7 @@ type ListBucketResp_Buckets struct {
 func (x *ListBucketResp_Buckets) Reset() {
 	*x = ListBucketResp_Buckets{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[11]
+		mi := &file_msg_s3_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -751,7 +924,7 @@ func (x *ListBucketResp_Buckets) String() string {
 func (*ListBucketResp_Buckets) ProtoMessage() {}
 
 func (x *ListBucketResp_Buckets) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[11]
+	mi := &file_msg_s3_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -798,7 +971,7 @@ type ListObjectResp_FileMetaList struct {
 func (x *ListObjectResp_FileMetaList) Reset() {
 	*x = ListObjectResp_FileMetaList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[12]
+		mi := &file_msg_s3_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -811,7 +984,7 @@ func (x *ListObjectResp_FileMetaList) String() string {
 func (*ListObjectResp_FileMetaList) ProtoMessage() {}
 
 func (x *ListObjectResp_FileMetaList) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[12]
+	mi := &file_msg_s3_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1063,7 @@ type ListObjectResp_FileMetaList_FileId struct {
 func (x *ListObjectResp_FileMetaList_FileId) Reset() {
 	*x = ListObjectResp_FileMetaList_FileId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[13]
+		mi := &file_msg_s3_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -903,7 +1076,7 @@ func (x *ListObjectResp_FileMetaList_FileId) String() string {
 func (*ListObjectResp_FileMetaList_FileId) ProtoMessage() {}
 
 func (x *ListObjectResp_FileMetaList_FileId) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[13]
+	mi := &file_msg_s3_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,7 +1134,7 @@ type ListObjectResp_FileMetaList_BucketId struct {
 func (x *ListObjectResp_FileMetaList_BucketId) Reset() {
 	*x = ListObjectResp_FileMetaList_BucketId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[14]
+		mi := &file_msg_s3_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -974,7 +1147,7 @@ func (x *ListObjectResp_FileMetaList_BucketId) String() string {
 func (*ListObjectResp_FileMetaList_BucketId) ProtoMessage() {}
 
 func (x *ListObjectResp_FileMetaList_BucketId) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[14]
+	mi := &file_msg_s3_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,7 +1205,7 @@ type ListObjectResp_FileMetaList_VersionId struct {
 func (x *ListObjectResp_FileMetaList_VersionId) Reset() {
 	*x = ListObjectResp_FileMetaList_VersionId{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[15]
+		mi := &file_msg_s3_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1045,7 +1218,7 @@ func (x *ListObjectResp_FileMetaList_VersionId) String() string {
 func (*ListObjectResp_FileMetaList_VersionId) ProtoMessage() {}
 
 func (x *ListObjectResp_FileMetaList_VersionId) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[15]
+	mi := &file_msg_s3_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,7 +1275,7 @@ type StringMap_Vals struct {
 func (x *StringMap_Vals) Reset() {
 	*x = StringMap_Vals{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_s3_proto_msgTypes[16]
+		mi := &file_msg_s3_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1115,7 +1288,7 @@ func (x *StringMap_Vals) String() string {
 func (*StringMap_Vals) ProtoMessage() {}
 
 func (x *StringMap_Vals) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_s3_proto_msgTypes[16]
+	mi := &file_msg_s3_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,76 +1406,92 @@ var file_msg_s3_proto_rawDesc = []byte{
 	0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x35, 0x0a, 0x07, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73,
 	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
 	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xf5, 0x06, 0x0a,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0xbb, 0x07, 0x0a,
 	0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12,
 	0x44, 0x0a, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x6d, 0x65, 0x74, 0x61, 0x6c, 0x69, 0x73, 0x74, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0a, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
 	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d,
 	0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x6d, 0x65, 0x74,
-	0x61, 0x6c, 0x69, 0x73, 0x74, 0x1a, 0x9c, 0x06, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65,
-	0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x27, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x52,
-	0x06, 0x66, 0x69, 0x6c, 0x65, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x08, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x29, 0x2e, 0x70, 0x6b, 0x74, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x46,
-	0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x49, 0x64, 0x52, 0x08, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x09, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x2a, 0x2e,
-	0x70, 0x6b, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x2e,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61,
-	0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x61,
-	0x63, 0x6c, 0x1a, 0x9c, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d,
+	0x61, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x1a, 0x9c, 0x06, 0x0a, 0x0c,
+	0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x65, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x27, 0x2e, 0x70,
+	0x6b, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x49, 0x64, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x65, 0x69, 0x64, 0x12, 0x45, 0x0a,
+	0x08, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0a, 0x32,
+	0x29, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x4c, 0x69, 0x73,
+	0x74, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x52, 0x08, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x48, 0x0a, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0a, 0x32, 0x2a, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x4c, 0x69, 0x73, 0x74, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52,
+	0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x1a, 0x9c, 0x01, 0x0a, 0x06, 0x46, 0x69, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61,
+	0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x1a, 0x9e, 0x01, 0x0a, 0x08, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d,
 	0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f,
-	0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x72, 0x1a, 0x9e, 0x01, 0x0a, 0x08, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1c,
-	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11,
-	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72,
-	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x65, 0x72, 0x1a, 0x9f, 0x01, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c,
-	0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
-	0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69,
-	0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x56, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
-	0x6c, 0x61, 0x73, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x09, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x27, 0x0a, 0x04, 0x76, 0x61,
-	0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0a, 0x32, 0x13, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x2e, 0x56, 0x61, 0x6c, 0x73, 0x52, 0x04, 0x76,
-	0x61, 0x6c, 0x73, 0x1a, 0x40, 0x0a, 0x04, 0x56, 0x61, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x69,
-	0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x69, 0x76, 0x61, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x6c, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6c,
-	0x76, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x76, 0x61, 0x6c,
+	0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x1a, 0x9f, 0x01, 0x0a, 0x09, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x42, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x56, 0x32, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x8a,
+	0x01, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73,
+	0x12, 0x27, 0x0a, 0x04, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0a, 0x32, 0x13,
+	0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x2e, 0x56,
+	0x61, 0x6c, 0x73, 0x52, 0x04, 0x76, 0x61, 0x6c, 0x73, 0x1a, 0x40, 0x0a, 0x04, 0x56, 0x61, 0x6c,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x76, 0x61, 0x6c, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x6c, 0x76, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x76, 0x61, 0x6c,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x2a, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x24, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x61,
+	0x63, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x61, 0x63, 0x6c, 0x22, 0x67, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x74,
+	0x61, 0x69, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x67, 0x61,
+	0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x65, 0x67,
+	0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64,
 }
 
 var (
@@ -1317,7 +1506,7 @@ func file_msg_s3_proto_rawDescGZIP() []byte {
 	return file_msg_s3_proto_rawDescData
 }
 
-var file_msg_s3_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_msg_s3_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_msg_s3_proto_goTypes = []interface{}{
 	(*CopyObjectResp)(nil),                        // 0: pkt.CopyObjectResp
 	(*GetBucketResp)(nil),                         // 1: pkt.GetBucketResp
@@ -1326,28 +1515,31 @@ var file_msg_s3_proto_goTypes = []interface{}{
 	(*ListObjectResp)(nil),                        // 4: pkt.ListObjectResp
 	(*ListObjectRespV2)(nil),                      // 5: pkt.ListObjectRespV2
 	(*StringMap)(nil),                             // 6: pkt.StringMap
-	(*CopyObjectResp_BucketId)(nil),               // 7: pkt.CopyObjectResp.BucketId
-	(*CopyObjectResp_VersionId)(nil),              // 8: pkt.CopyObjectResp.VersionId
-	(*CopyObjectResp_FileId)(nil),                 // 9: pkt.CopyObjectResp.FileId
-	(*GetObjectResp_Id)(nil),                      // 10: pkt.GetObjectResp.Id
-	(*ListBucketResp_Buckets)(nil),                // 11: pkt.ListBucketResp.Buckets
-	(*ListObjectResp_FileMetaList)(nil),           // 12: pkt.ListObjectResp.FileMetaList
-	(*ListObjectResp_FileMetaList_FileId)(nil),    // 13: pkt.ListObjectResp.FileMetaList.FileId
-	(*ListObjectResp_FileMetaList_BucketId)(nil),  // 14: pkt.ListObjectResp.FileMetaList.BucketId
-	(*ListObjectResp_FileMetaList_VersionId)(nil), // 15: pkt.ListObjectResp.FileMetaList.VersionId
-	(*StringMap_Vals)(nil),                        // 16: pkt.StringMap.Vals
+	(*GetObjectTaggingResp)(nil),                  // 7: pkt.GetObjectTaggingResp
+	(*GetObjectAclResp)(nil),                      // 8: pkt.GetObjectAclResp
+	(*GetObjectLockResp)(nil),                     // 9: pkt.GetObjectLockResp
+	(*CopyObjectResp_BucketId)(nil),               // 10: pkt.CopyObjectResp.BucketId
+	(*CopyObjectResp_VersionId)(nil),              // 11: pkt.CopyObjectResp.VersionId
+	(*CopyObjectResp_FileId)(nil),                 // 12: pkt.CopyObjectResp.FileId
+	(*GetObjectResp_Id)(nil),                      // 13: pkt.GetObjectResp.Id
+	(*ListBucketResp_Buckets)(nil),                // 14: pkt.ListBucketResp.Buckets
+	(*ListObjectResp_FileMetaList)(nil),           // 15: pkt.ListObjectResp.FileMetaList
+	(*ListObjectResp_FileMetaList_FileId)(nil),    // 16: pkt.ListObjectResp.FileMetaList.FileId
+	(*ListObjectResp_FileMetaList_BucketId)(nil),  // 17: pkt.ListObjectResp.FileMetaList.BucketId
+	(*ListObjectResp_FileMetaList_VersionId)(nil), // 18: pkt.ListObjectResp.FileMetaList.VersionId
+	(*StringMap_Vals)(nil),                        // 19: pkt.StringMap.Vals
 }
 var file_msg_s3_proto_depIdxs = []int32{
-	7,  // 0: pkt.CopyObjectResp.bucketid:type_name -> pkt.CopyObjectResp.BucketId
-	8,  // 1: pkt.CopyObjectResp.versionid:type_name -> pkt.CopyObjectResp.VersionId
-	9,  // 2: pkt.CopyObjectResp.fileid:type_name -> pkt.CopyObjectResp.FileId
-	10, // 3: pkt.GetObjectResp.id:type_name -> pkt.GetObjectResp.Id
-	11, // 4: pkt.ListBucketResp.buckets:type_name -> pkt.ListBucketResp.Buckets
-	12, // 5: pkt.ListObjectResp.filemetalist:type_name -> pkt.ListObjectResp.FileMetaList
-	16, // 6: pkt.StringMap.vals:type_name -> pkt.StringMap.Vals
-	13, // 7: pkt.ListObjectResp.FileMetaList.fileid:type_name -> pkt.ListObjectResp.FileMetaList.FileId
-	14, // 8: pkt.ListObjectResp.FileMetaList.bucketid:type_name -> pkt.ListObjectResp.FileMetaList.BucketId
-	15, // 9: pkt.ListObjectResp.FileMetaList.versionid:type_name -> pkt.ListObjectResp.FileMetaList.VersionId
+	10, // 0: pkt.CopyObjectResp.bucketid:type_name -> pkt.CopyObjectResp.BucketId
+	11, // 1: pkt.CopyObjectResp.versionid:type_name -> pkt.CopyObjectResp.VersionId
+	12, // 2: pkt.CopyObjectResp.fileid:type_name -> pkt.CopyObjectResp.FileId
+	13, // 3: pkt.GetObjectResp.id:type_name -> pkt.GetObjectResp.Id
+	14, // 4: pkt.ListBucketResp.buckets:type_name -> pkt.ListBucketResp.Buckets
+	15, // 5: pkt.ListObjectResp.filemetalist:type_name -> pkt.ListObjectResp.FileMetaList
+	19, // 6: pkt.StringMap.vals:type_name -> pkt.StringMap.Vals
+	16, // 7: pkt.ListObjectResp.FileMetaList.fileid:type_name -> pkt.ListObjectResp.FileMetaList.FileId
+	17, // 8: pkt.ListObjectResp.FileMetaList.bucketid:type_name -> pkt.ListObjectResp.FileMetaList.BucketId
+	18, // 9: pkt.ListObjectResp.FileMetaList.versionid:type_name -> pkt.ListObjectResp.FileMetaList.VersionId
 	10, // [10:10] is the sub-list for method output_type
 	10, // [10:10] is the sub-list for method input_type
 	10, // [10:10] is the sub-list for extension type_name
@@ -1446,7 +1638,7 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyObjectResp_BucketId); i {
+			switch v := v.(*GetObjectTaggingResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1458,7 +1650,7 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyObjectResp_VersionId); i {
+			switch v := v.(*GetObjectAclResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1470,7 +1662,7 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CopyObjectResp_FileId); i {
+			switch v := v.(*GetObjectLockResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1482,7 +1674,7 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetObjectResp_Id); i {
+			switch v := v.(*CopyObjectResp_BucketId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1494,7 +1686,7 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBucketResp_Buckets); i {
+			switch v := v.(*CopyObjectResp_VersionId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1506,7 +1698,7 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListObjectResp_FileMetaList); i {
+			switch v := v.(*CopyObjectResp_FileId); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,7 +1710,7 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListObjectResp_FileMetaList_FileId); i {
+			switch v := v.(*GetObjectResp_Id); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,7 +1722,7 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListObjectResp_FileMetaList_BucketId); i {
+			switch v := v.(*ListBucketResp_Buckets); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1542,7 +1734,7 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListObjectResp_FileMetaList_VersionId); i {
+			switch v := v.(*ListObjectResp_FileMetaList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1554,6 +1746,42 @@ func file_msg_s3_proto_init() {
 			}
 		}
 		file_msg_s3_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListObjectResp_FileMetaList_FileId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListObjectResp_FileMetaList_BucketId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListObjectResp_FileMetaList_VersionId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StringMap_Vals); i {
 			case 0:
 				return &v.state
@@ -1572,7 +1800,7 @@ func file_msg_s3_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_msg_s3_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
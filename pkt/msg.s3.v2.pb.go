@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.21.0
+// 	protoc-gen-go v1.25.0
 // 	protoc        v3.21.2
 // source: msg.s3.v2.proto
 
@@ -283,13 +283,14 @@ type DeleteFileReqV2 struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId     *uint32              `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
-	SignData   *string              `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
-	KeyNumber  *uint32              `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
-	BucketName *string              `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
-	FileName   *string              `protobuf:"bytes,5,opt,name=fileName" json:"fileName,omitempty"`
-	Vnu        *DeleteFileReqV2_VNU `protobuf:"group,6,opt,name=VNU,json=vnu" json:"vnu,omitempty"`
-	Meta       []byte               `protobuf:"bytes,7,opt,name=meta" json:"meta,omitempty"`
+	UserId           *uint32              `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData         *string              `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber        *uint32              `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName       *string              `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	FileName         *string              `protobuf:"bytes,5,opt,name=fileName" json:"fileName,omitempty"`
+	Vnu              *DeleteFileReqV2_VNU `protobuf:"group,6,opt,name=VNU,json=vnu" json:"vnu,omitempty"`
+	Meta             []byte               `protobuf:"bytes,7,opt,name=meta" json:"meta,omitempty"`
+	BypassGovernance *bool                `protobuf:"varint,8,opt,name=bypassGovernance" json:"bypassGovernance,omitempty"`
 }
 
 func (x *DeleteFileReqV2) Reset() {
@@ -373,6 +374,13 @@ func (x *DeleteFileReqV2) GetMeta() []byte {
 	return nil
 }
 
+func (x *DeleteFileReqV2) GetBypassGovernance() bool {
+	if x != nil && x.BypassGovernance != nil {
+		return *x.BypassGovernance
+	}
+	return false
+}
+
 type GetBucketReqV2 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -609,6 +617,7 @@ type ListObjectReqV2 struct {
 	Prefix        *string                        `protobuf:"bytes,8,opt,name=prefix" json:"prefix,omitempty"`
 	Version       *bool                          `protobuf:"varint,9,opt,name=version" json:"version,omitempty"`
 	Nextversionid *ListObjectReqV2_NextVersionId `protobuf:"group,10,opt,name=NextVersionId,json=nextversionid" json:"nextversionid,omitempty"`
+	Delimiter     *string                        `protobuf:"bytes,11,opt,name=delimiter" json:"delimiter,omitempty"`
 }
 
 func (x *ListObjectReqV2) Reset() {
@@ -713,6 +722,13 @@ func (x *ListObjectReqV2) GetNextversionid() *ListObjectReqV2_NextVersionId {
 	return nil
 }
 
+func (x *ListObjectReqV2) GetDelimiter() string {
+	if x != nil && x.Delimiter != nil {
+		return *x.Delimiter
+	}
+	return ""
+}
+
 type UpdateBucketReqV2 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -887,19 +903,21 @@ func (x *UploadFileReqV2) GetMeta() []byte {
 	return nil
 }
 
-type DeleteFileReqV2_VNU struct {
+type GetObjectTaggingReqV2 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
-	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
-	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
-	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+	UserId     *uint32                    `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData   *string                    `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber  *uint32                    `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName *string                    `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	FileName   *string                    `protobuf:"bytes,5,opt,name=fileName" json:"fileName,omitempty"`
+	Vnu        *GetObjectTaggingReqV2_VNU `protobuf:"group,6,opt,name=VNU,json=vnu" json:"vnu,omitempty"`
 }
 
-func (x *DeleteFileReqV2_VNU) Reset() {
-	*x = DeleteFileReqV2_VNU{}
+func (x *GetObjectTaggingReqV2) Reset() {
+	*x = GetObjectTaggingReqV2{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_s3_v2_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -907,13 +925,13 @@ func (x *DeleteFileReqV2_VNU) Reset() {
 	}
 }
 
-func (x *DeleteFileReqV2_VNU) String() string {
+func (x *GetObjectTaggingReqV2) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteFileReqV2_VNU) ProtoMessage() {}
+func (*GetObjectTaggingReqV2) ProtoMessage() {}
 
-func (x *DeleteFileReqV2_VNU) ProtoReflect() protoreflect.Message {
+func (x *GetObjectTaggingReqV2) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_s3_v2_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -925,52 +943,69 @@ func (x *DeleteFileReqV2_VNU) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteFileReqV2_VNU.ProtoReflect.Descriptor instead.
-func (*DeleteFileReqV2_VNU) Descriptor() ([]byte, []int) {
-	return file_msg_s3_v2_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use GetObjectTaggingReqV2.ProtoReflect.Descriptor instead.
+func (*GetObjectTaggingReqV2) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteFileReqV2_VNU) GetTimestamp() uint32 {
-	if x != nil && x.Timestamp != nil {
-		return *x.Timestamp
+func (x *GetObjectTaggingReqV2) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return 0
 }
 
-func (x *DeleteFileReqV2_VNU) GetMachineIdentifier() int32 {
-	if x != nil && x.MachineIdentifier != nil {
-		return *x.MachineIdentifier
+func (x *GetObjectTaggingReqV2) GetSignData() string {
+	if x != nil && x.SignData != nil {
+		return *x.SignData
 	}
-	return 0
+	return ""
 }
 
-func (x *DeleteFileReqV2_VNU) GetProcessIdentifier() uint32 {
-	if x != nil && x.ProcessIdentifier != nil {
-		return *x.ProcessIdentifier
+func (x *GetObjectTaggingReqV2) GetKeyNumber() uint32 {
+	if x != nil && x.KeyNumber != nil {
+		return *x.KeyNumber
 	}
 	return 0
 }
 
-func (x *DeleteFileReqV2_VNU) GetCounter() int32 {
-	if x != nil && x.Counter != nil {
-		return *x.Counter
+func (x *GetObjectTaggingReqV2) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
 	}
-	return 0
+	return ""
 }
 
-type ListObjectReqV2_StartId struct {
+func (x *GetObjectTaggingReqV2) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *GetObjectTaggingReqV2) GetVnu() *GetObjectTaggingReqV2_VNU {
+	if x != nil {
+		return x.Vnu
+	}
+	return nil
+}
+
+type PutObjectTaggingReqV2 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
-	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
-	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
-	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+	UserId     *uint32                    `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData   *string                    `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber  *uint32                    `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName *string                    `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	FileName   *string                    `protobuf:"bytes,5,opt,name=fileName" json:"fileName,omitempty"`
+	Vnu        *PutObjectTaggingReqV2_VNU `protobuf:"group,6,opt,name=VNU,json=vnu" json:"vnu,omitempty"`
+	Tags       []byte                     `protobuf:"bytes,7,opt,name=tags" json:"tags,omitempty"`
 }
 
-func (x *ListObjectReqV2_StartId) Reset() {
-	*x = ListObjectReqV2_StartId{}
+func (x *PutObjectTaggingReqV2) Reset() {
+	*x = PutObjectTaggingReqV2{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_s3_v2_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -978,13 +1013,13 @@ func (x *ListObjectReqV2_StartId) Reset() {
 	}
 }
 
-func (x *ListObjectReqV2_StartId) String() string {
+func (x *PutObjectTaggingReqV2) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectReqV2_StartId) ProtoMessage() {}
+func (*PutObjectTaggingReqV2) ProtoMessage() {}
 
-func (x *ListObjectReqV2_StartId) ProtoReflect() protoreflect.Message {
+func (x *PutObjectTaggingReqV2) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_s3_v2_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -996,52 +1031,77 @@ func (x *ListObjectReqV2_StartId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListObjectReqV2_StartId.ProtoReflect.Descriptor instead.
-func (*ListObjectReqV2_StartId) Descriptor() ([]byte, []int) {
-	return file_msg_s3_v2_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use PutObjectTaggingReqV2.ProtoReflect.Descriptor instead.
+func (*PutObjectTaggingReqV2) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListObjectReqV2_StartId) GetTimestamp() uint32 {
-	if x != nil && x.Timestamp != nil {
-		return *x.Timestamp
+func (x *PutObjectTaggingReqV2) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return 0
 }
 
-func (x *ListObjectReqV2_StartId) GetMachineIdentifier() int32 {
-	if x != nil && x.MachineIdentifier != nil {
-		return *x.MachineIdentifier
+func (x *PutObjectTaggingReqV2) GetSignData() string {
+	if x != nil && x.SignData != nil {
+		return *x.SignData
 	}
-	return 0
+	return ""
 }
 
-func (x *ListObjectReqV2_StartId) GetProcessIdentifier() uint32 {
-	if x != nil && x.ProcessIdentifier != nil {
-		return *x.ProcessIdentifier
+func (x *PutObjectTaggingReqV2) GetKeyNumber() uint32 {
+	if x != nil && x.KeyNumber != nil {
+		return *x.KeyNumber
 	}
 	return 0
 }
 
-func (x *ListObjectReqV2_StartId) GetCounter() int32 {
-	if x != nil && x.Counter != nil {
-		return *x.Counter
+func (x *PutObjectTaggingReqV2) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
 	}
-	return 0
+	return ""
 }
 
-type ListObjectReqV2_NextVersionId struct {
+func (x *PutObjectTaggingReqV2) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *PutObjectTaggingReqV2) GetVnu() *PutObjectTaggingReqV2_VNU {
+	if x != nil {
+		return x.Vnu
+	}
+	return nil
+}
+
+func (x *PutObjectTaggingReqV2) GetTags() []byte {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type ListObjectByTagReqV2 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
-	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
-	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
-	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+	UserId     *uint32                       `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData   *string                       `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber  *uint32                       `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName *string                       `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	TagKey     *string                       `protobuf:"bytes,5,opt,name=tagKey" json:"tagKey,omitempty"`
+	TagValue   *string                       `protobuf:"bytes,6,opt,name=tagValue" json:"tagValue,omitempty"`
+	Limit      *uint32                       `protobuf:"varint,8,opt,name=limit" json:"limit,omitempty"`
+	Startid    *ListObjectByTagReqV2_StartId `protobuf:"group,9,opt,name=StartId,json=startid" json:"startid,omitempty"`
 }
 
-func (x *ListObjectReqV2_NextVersionId) Reset() {
-	*x = ListObjectReqV2_NextVersionId{}
+func (x *ListObjectByTagReqV2) Reset() {
+	*x = ListObjectByTagReqV2{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_s3_v2_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1049,13 +1109,13 @@ func (x *ListObjectReqV2_NextVersionId) Reset() {
 	}
 }
 
-func (x *ListObjectReqV2_NextVersionId) String() string {
+func (x *ListObjectByTagReqV2) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListObjectReqV2_NextVersionId) ProtoMessage() {}
+func (*ListObjectByTagReqV2) ProtoMessage() {}
 
-func (x *ListObjectReqV2_NextVersionId) ProtoReflect() protoreflect.Message {
+func (x *ListObjectByTagReqV2) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_s3_v2_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1067,52 +1127,82 @@ func (x *ListObjectReqV2_NextVersionId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListObjectReqV2_NextVersionId.ProtoReflect.Descriptor instead.
-func (*ListObjectReqV2_NextVersionId) Descriptor() ([]byte, []int) {
-	return file_msg_s3_v2_proto_rawDescGZIP(), []int{7, 1}
+// Deprecated: Use ListObjectByTagReqV2.ProtoReflect.Descriptor instead.
+func (*ListObjectByTagReqV2) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListObjectReqV2_NextVersionId) GetTimestamp() uint32 {
-	if x != nil && x.Timestamp != nil {
-		return *x.Timestamp
+func (x *ListObjectByTagReqV2) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return 0
 }
 
-func (x *ListObjectReqV2_NextVersionId) GetMachineIdentifier() int32 {
-	if x != nil && x.MachineIdentifier != nil {
-		return *x.MachineIdentifier
+func (x *ListObjectByTagReqV2) GetSignData() string {
+	if x != nil && x.SignData != nil {
+		return *x.SignData
 	}
-	return 0
+	return ""
 }
 
-func (x *ListObjectReqV2_NextVersionId) GetProcessIdentifier() uint32 {
-	if x != nil && x.ProcessIdentifier != nil {
-		return *x.ProcessIdentifier
+func (x *ListObjectByTagReqV2) GetKeyNumber() uint32 {
+	if x != nil && x.KeyNumber != nil {
+		return *x.KeyNumber
 	}
 	return 0
 }
 
-func (x *ListObjectReqV2_NextVersionId) GetCounter() int32 {
-	if x != nil && x.Counter != nil {
-		return *x.Counter
+func (x *ListObjectByTagReqV2) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
+	}
+	return ""
+}
+
+func (x *ListObjectByTagReqV2) GetTagKey() string {
+	if x != nil && x.TagKey != nil {
+		return *x.TagKey
+	}
+	return ""
+}
+
+func (x *ListObjectByTagReqV2) GetTagValue() string {
+	if x != nil && x.TagValue != nil {
+		return *x.TagValue
+	}
+	return ""
+}
+
+func (x *ListObjectByTagReqV2) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
 	}
 	return 0
 }
 
-type UploadFileReqV2_VNU struct {
+func (x *ListObjectByTagReqV2) GetStartid() *ListObjectByTagReqV2_StartId {
+	if x != nil {
+		return x.Startid
+	}
+	return nil
+}
+
+type GetObjectAclReqV2 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
-	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
-	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
-	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+	UserId     *uint32                `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData   *string                `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber  *uint32                `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName *string                `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	FileName   *string                `protobuf:"bytes,5,opt,name=fileName" json:"fileName,omitempty"`
+	Vnu        *GetObjectAclReqV2_VNU `protobuf:"group,6,opt,name=VNU,json=vnu" json:"vnu,omitempty"`
 }
 
-func (x *UploadFileReqV2_VNU) Reset() {
-	*x = UploadFileReqV2_VNU{}
+func (x *GetObjectAclReqV2) Reset() {
+	*x = GetObjectAclReqV2{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_s3_v2_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1120,13 +1210,13 @@ func (x *UploadFileReqV2_VNU) Reset() {
 	}
 }
 
-func (x *UploadFileReqV2_VNU) String() string {
+func (x *GetObjectAclReqV2) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFileReqV2_VNU) ProtoMessage() {}
+func (*GetObjectAclReqV2) ProtoMessage() {}
 
-func (x *UploadFileReqV2_VNU) ProtoReflect() protoreflect.Message {
+func (x *GetObjectAclReqV2) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_s3_v2_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1138,84 +1228,1425 @@ func (x *UploadFileReqV2_VNU) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFileReqV2_VNU.ProtoReflect.Descriptor instead.
-func (*UploadFileReqV2_VNU) Descriptor() ([]byte, []int) {
-	return file_msg_s3_v2_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use GetObjectAclReqV2.ProtoReflect.Descriptor instead.
+func (*GetObjectAclReqV2) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UploadFileReqV2_VNU) GetTimestamp() uint32 {
-	if x != nil && x.Timestamp != nil {
-		return *x.Timestamp
+func (x *GetObjectAclReqV2) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
 	}
 	return 0
 }
 
-func (x *UploadFileReqV2_VNU) GetMachineIdentifier() int32 {
-	if x != nil && x.MachineIdentifier != nil {
-		return *x.MachineIdentifier
+func (x *GetObjectAclReqV2) GetSignData() string {
+	if x != nil && x.SignData != nil {
+		return *x.SignData
 	}
-	return 0
+	return ""
 }
 
-func (x *UploadFileReqV2_VNU) GetProcessIdentifier() uint32 {
-	if x != nil && x.ProcessIdentifier != nil {
-		return *x.ProcessIdentifier
+func (x *GetObjectAclReqV2) GetKeyNumber() uint32 {
+	if x != nil && x.KeyNumber != nil {
+		return *x.KeyNumber
 	}
 	return 0
 }
 
-func (x *UploadFileReqV2_VNU) GetCounter() int32 {
-	if x != nil && x.Counter != nil {
-		return *x.Counter
+func (x *GetObjectAclReqV2) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
 	}
-	return 0
+	return ""
 }
 
-var File_msg_s3_v2_proto protoreflect.FileDescriptor
+func (x *GetObjectAclReqV2) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
 
-var file_msg_s3_v2_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x6d, 0x73, 0x67, 0x2e, 0x73, 0x33, 0x2e, 0x76, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x03, 0x70, 0x6b, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x70, 0x79, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x72, 0x63, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x72, 0x63, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x72,
-	0x63, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x73, 0x72, 0x63, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x64, 0x65, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x24,
-	0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x6d, 0x65, 0x74, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xfb, 0x02, 0x0a,
-	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x56, 0x32,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e,
-	0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62,
+func (x *GetObjectAclReqV2) GetVnu() *GetObjectAclReqV2_VNU {
+	if x != nil {
+		return x.Vnu
+	}
+	return nil
+}
+
+type PutObjectAclReqV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     *uint32                `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData   *string                `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber  *uint32                `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName *string                `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	FileName   *string                `protobuf:"bytes,5,opt,name=fileName" json:"fileName,omitempty"`
+	Vnu        *PutObjectAclReqV2_VNU `protobuf:"group,6,opt,name=VNU,json=vnu" json:"vnu,omitempty"`
+	Acl        []byte                 `protobuf:"bytes,7,opt,name=acl" json:"acl,omitempty"`
+}
+
+func (x *PutObjectAclReqV2) Reset() {
+	*x = PutObjectAclReqV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutObjectAclReqV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectAclReqV2) ProtoMessage() {}
+
+func (x *PutObjectAclReqV2) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectAclReqV2.ProtoReflect.Descriptor instead.
+func (*PutObjectAclReqV2) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PutObjectAclReqV2) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *PutObjectAclReqV2) GetSignData() string {
+	if x != nil && x.SignData != nil {
+		return *x.SignData
+	}
+	return ""
+}
+
+func (x *PutObjectAclReqV2) GetKeyNumber() uint32 {
+	if x != nil && x.KeyNumber != nil {
+		return *x.KeyNumber
+	}
+	return 0
+}
+
+func (x *PutObjectAclReqV2) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
+	}
+	return ""
+}
+
+func (x *PutObjectAclReqV2) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *PutObjectAclReqV2) GetVnu() *PutObjectAclReqV2_VNU {
+	if x != nil {
+		return x.Vnu
+	}
+	return nil
+}
+
+func (x *PutObjectAclReqV2) GetAcl() []byte {
+	if x != nil {
+		return x.Acl
+	}
+	return nil
+}
+
+type GetObjectLockReqV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     *uint32                 `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData   *string                 `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber  *uint32                 `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName *string                 `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	FileName   *string                 `protobuf:"bytes,5,opt,name=fileName" json:"fileName,omitempty"`
+	Vnu        *GetObjectLockReqV2_VNU `protobuf:"group,6,opt,name=VNU,json=vnu" json:"vnu,omitempty"`
+}
+
+func (x *GetObjectLockReqV2) Reset() {
+	*x = GetObjectLockReqV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectLockReqV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectLockReqV2) ProtoMessage() {}
+
+func (x *GetObjectLockReqV2) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectLockReqV2.ProtoReflect.Descriptor instead.
+func (*GetObjectLockReqV2) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetObjectLockReqV2) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *GetObjectLockReqV2) GetSignData() string {
+	if x != nil && x.SignData != nil {
+		return *x.SignData
+	}
+	return ""
+}
+
+func (x *GetObjectLockReqV2) GetKeyNumber() uint32 {
+	if x != nil && x.KeyNumber != nil {
+		return *x.KeyNumber
+	}
+	return 0
+}
+
+func (x *GetObjectLockReqV2) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
+	}
+	return ""
+}
+
+func (x *GetObjectLockReqV2) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *GetObjectLockReqV2) GetVnu() *GetObjectLockReqV2_VNU {
+	if x != nil {
+		return x.Vnu
+	}
+	return nil
+}
+
+type PutObjectRetentionReqV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId           *uint32                      `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData         *string                      `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber        *uint32                      `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName       *string                      `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	FileName         *string                      `protobuf:"bytes,5,opt,name=fileName" json:"fileName,omitempty"`
+	Vnu              *PutObjectRetentionReqV2_VNU `protobuf:"group,6,opt,name=VNU,json=vnu" json:"vnu,omitempty"`
+	Mode             *string                      `protobuf:"bytes,7,opt,name=mode" json:"mode,omitempty"`
+	RetainUntil      *int64                       `protobuf:"varint,8,opt,name=retainUntil" json:"retainUntil,omitempty"`
+	BypassGovernance *bool                        `protobuf:"varint,9,opt,name=bypassGovernance" json:"bypassGovernance,omitempty"`
+}
+
+func (x *PutObjectRetentionReqV2) Reset() {
+	*x = PutObjectRetentionReqV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutObjectRetentionReqV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectRetentionReqV2) ProtoMessage() {}
+
+func (x *PutObjectRetentionReqV2) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectRetentionReqV2.ProtoReflect.Descriptor instead.
+func (*PutObjectRetentionReqV2) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PutObjectRetentionReqV2) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *PutObjectRetentionReqV2) GetSignData() string {
+	if x != nil && x.SignData != nil {
+		return *x.SignData
+	}
+	return ""
+}
+
+func (x *PutObjectRetentionReqV2) GetKeyNumber() uint32 {
+	if x != nil && x.KeyNumber != nil {
+		return *x.KeyNumber
+	}
+	return 0
+}
+
+func (x *PutObjectRetentionReqV2) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
+	}
+	return ""
+}
+
+func (x *PutObjectRetentionReqV2) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *PutObjectRetentionReqV2) GetVnu() *PutObjectRetentionReqV2_VNU {
+	if x != nil {
+		return x.Vnu
+	}
+	return nil
+}
+
+func (x *PutObjectRetentionReqV2) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *PutObjectRetentionReqV2) GetRetainUntil() int64 {
+	if x != nil && x.RetainUntil != nil {
+		return *x.RetainUntil
+	}
+	return 0
+}
+
+func (x *PutObjectRetentionReqV2) GetBypassGovernance() bool {
+	if x != nil && x.BypassGovernance != nil {
+		return *x.BypassGovernance
+	}
+	return false
+}
+
+type PutObjectLegalHoldReqV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     *uint32                      `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData   *string                      `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber  *uint32                      `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName *string                      `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	FileName   *string                      `protobuf:"bytes,5,opt,name=fileName" json:"fileName,omitempty"`
+	Vnu        *PutObjectLegalHoldReqV2_VNU `protobuf:"group,6,opt,name=VNU,json=vnu" json:"vnu,omitempty"`
+	LegalHold  *bool                        `protobuf:"varint,7,opt,name=legalHold" json:"legalHold,omitempty"`
+}
+
+func (x *PutObjectLegalHoldReqV2) Reset() {
+	*x = PutObjectLegalHoldReqV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutObjectLegalHoldReqV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectLegalHoldReqV2) ProtoMessage() {}
+
+func (x *PutObjectLegalHoldReqV2) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectLegalHoldReqV2.ProtoReflect.Descriptor instead.
+func (*PutObjectLegalHoldReqV2) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PutObjectLegalHoldReqV2) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *PutObjectLegalHoldReqV2) GetSignData() string {
+	if x != nil && x.SignData != nil {
+		return *x.SignData
+	}
+	return ""
+}
+
+func (x *PutObjectLegalHoldReqV2) GetKeyNumber() uint32 {
+	if x != nil && x.KeyNumber != nil {
+		return *x.KeyNumber
+	}
+	return 0
+}
+
+func (x *PutObjectLegalHoldReqV2) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
+	}
+	return ""
+}
+
+func (x *PutObjectLegalHoldReqV2) GetFileName() string {
+	if x != nil && x.FileName != nil {
+		return *x.FileName
+	}
+	return ""
+}
+
+func (x *PutObjectLegalHoldReqV2) GetVnu() *PutObjectLegalHoldReqV2_VNU {
+	if x != nil {
+		return x.Vnu
+	}
+	return nil
+}
+
+func (x *PutObjectLegalHoldReqV2) GetLegalHold() bool {
+	if x != nil && x.LegalHold != nil {
+		return *x.LegalHold
+	}
+	return false
+}
+
+type SetBucketMetaReqV2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     *uint32 `protobuf:"varint,1,opt,name=userId" json:"userId,omitempty"`
+	SignData   *string `protobuf:"bytes,2,opt,name=signData" json:"signData,omitempty"`
+	KeyNumber  *uint32 `protobuf:"varint,3,opt,name=keyNumber" json:"keyNumber,omitempty"`
+	BucketName *string `protobuf:"bytes,4,opt,name=bucketName" json:"bucketName,omitempty"`
+	Key        *string `protobuf:"bytes,5,opt,name=key" json:"key,omitempty"`
+	Value      *string `protobuf:"bytes,6,opt,name=value" json:"value,omitempty"`
+}
+
+func (x *SetBucketMetaReqV2) Reset() {
+	*x = SetBucketMetaReqV2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetBucketMetaReqV2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBucketMetaReqV2) ProtoMessage() {}
+
+func (x *SetBucketMetaReqV2) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBucketMetaReqV2.ProtoReflect.Descriptor instead.
+func (*SetBucketMetaReqV2) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetBucketMetaReqV2) GetUserId() uint32 {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return 0
+}
+
+func (x *SetBucketMetaReqV2) GetSignData() string {
+	if x != nil && x.SignData != nil {
+		return *x.SignData
+	}
+	return ""
+}
+
+func (x *SetBucketMetaReqV2) GetKeyNumber() uint32 {
+	if x != nil && x.KeyNumber != nil {
+		return *x.KeyNumber
+	}
+	return 0
+}
+
+func (x *SetBucketMetaReqV2) GetBucketName() string {
+	if x != nil && x.BucketName != nil {
+		return *x.BucketName
+	}
+	return ""
+}
+
+func (x *SetBucketMetaReqV2) GetKey() string {
+	if x != nil && x.Key != nil {
+		return *x.Key
+	}
+	return ""
+}
+
+func (x *SetBucketMetaReqV2) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return ""
+}
+
+type DeleteFileReqV2_VNU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *DeleteFileReqV2_VNU) Reset() {
+	*x = DeleteFileReqV2_VNU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFileReqV2_VNU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFileReqV2_VNU) ProtoMessage() {}
+
+func (x *DeleteFileReqV2_VNU) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFileReqV2_VNU.ProtoReflect.Descriptor instead.
+func (*DeleteFileReqV2_VNU) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *DeleteFileReqV2_VNU) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *DeleteFileReqV2_VNU) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *DeleteFileReqV2_VNU) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *DeleteFileReqV2_VNU) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type ListObjectReqV2_StartId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *ListObjectReqV2_StartId) Reset() {
+	*x = ListObjectReqV2_StartId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListObjectReqV2_StartId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectReqV2_StartId) ProtoMessage() {}
+
+func (x *ListObjectReqV2_StartId) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectReqV2_StartId.ProtoReflect.Descriptor instead.
+func (*ListObjectReqV2_StartId) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *ListObjectReqV2_StartId) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *ListObjectReqV2_StartId) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *ListObjectReqV2_StartId) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *ListObjectReqV2_StartId) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type ListObjectReqV2_NextVersionId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *ListObjectReqV2_NextVersionId) Reset() {
+	*x = ListObjectReqV2_NextVersionId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListObjectReqV2_NextVersionId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectReqV2_NextVersionId) ProtoMessage() {}
+
+func (x *ListObjectReqV2_NextVersionId) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectReqV2_NextVersionId.ProtoReflect.Descriptor instead.
+func (*ListObjectReqV2_NextVersionId) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *ListObjectReqV2_NextVersionId) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *ListObjectReqV2_NextVersionId) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *ListObjectReqV2_NextVersionId) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *ListObjectReqV2_NextVersionId) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type UploadFileReqV2_VNU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *UploadFileReqV2_VNU) Reset() {
+	*x = UploadFileReqV2_VNU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFileReqV2_VNU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFileReqV2_VNU) ProtoMessage() {}
+
+func (x *UploadFileReqV2_VNU) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFileReqV2_VNU.ProtoReflect.Descriptor instead.
+func (*UploadFileReqV2_VNU) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *UploadFileReqV2_VNU) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *UploadFileReqV2_VNU) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *UploadFileReqV2_VNU) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *UploadFileReqV2_VNU) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type GetObjectTaggingReqV2_VNU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *GetObjectTaggingReqV2_VNU) Reset() {
+	*x = GetObjectTaggingReqV2_VNU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectTaggingReqV2_VNU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectTaggingReqV2_VNU) ProtoMessage() {}
+
+func (x *GetObjectTaggingReqV2_VNU) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectTaggingReqV2_VNU.ProtoReflect.Descriptor instead.
+func (*GetObjectTaggingReqV2_VNU) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *GetObjectTaggingReqV2_VNU) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *GetObjectTaggingReqV2_VNU) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *GetObjectTaggingReqV2_VNU) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *GetObjectTaggingReqV2_VNU) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type PutObjectTaggingReqV2_VNU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *PutObjectTaggingReqV2_VNU) Reset() {
+	*x = PutObjectTaggingReqV2_VNU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutObjectTaggingReqV2_VNU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectTaggingReqV2_VNU) ProtoMessage() {}
+
+func (x *PutObjectTaggingReqV2_VNU) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectTaggingReqV2_VNU.ProtoReflect.Descriptor instead.
+func (*PutObjectTaggingReqV2_VNU) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *PutObjectTaggingReqV2_VNU) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *PutObjectTaggingReqV2_VNU) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *PutObjectTaggingReqV2_VNU) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *PutObjectTaggingReqV2_VNU) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type ListObjectByTagReqV2_StartId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *ListObjectByTagReqV2_StartId) Reset() {
+	*x = ListObjectByTagReqV2_StartId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListObjectByTagReqV2_StartId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListObjectByTagReqV2_StartId) ProtoMessage() {}
+
+func (x *ListObjectByTagReqV2_StartId) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListObjectByTagReqV2_StartId.ProtoReflect.Descriptor instead.
+func (*ListObjectByTagReqV2_StartId) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *ListObjectByTagReqV2_StartId) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *ListObjectByTagReqV2_StartId) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *ListObjectByTagReqV2_StartId) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *ListObjectByTagReqV2_StartId) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type GetObjectAclReqV2_VNU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *GetObjectAclReqV2_VNU) Reset() {
+	*x = GetObjectAclReqV2_VNU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectAclReqV2_VNU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectAclReqV2_VNU) ProtoMessage() {}
+
+func (x *GetObjectAclReqV2_VNU) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectAclReqV2_VNU.ProtoReflect.Descriptor instead.
+func (*GetObjectAclReqV2_VNU) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *GetObjectAclReqV2_VNU) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *GetObjectAclReqV2_VNU) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *GetObjectAclReqV2_VNU) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *GetObjectAclReqV2_VNU) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type PutObjectAclReqV2_VNU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *PutObjectAclReqV2_VNU) Reset() {
+	*x = PutObjectAclReqV2_VNU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutObjectAclReqV2_VNU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectAclReqV2_VNU) ProtoMessage() {}
+
+func (x *PutObjectAclReqV2_VNU) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectAclReqV2_VNU.ProtoReflect.Descriptor instead.
+func (*PutObjectAclReqV2_VNU) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *PutObjectAclReqV2_VNU) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *PutObjectAclReqV2_VNU) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *PutObjectAclReqV2_VNU) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *PutObjectAclReqV2_VNU) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type GetObjectLockReqV2_VNU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *GetObjectLockReqV2_VNU) Reset() {
+	*x = GetObjectLockReqV2_VNU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetObjectLockReqV2_VNU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetObjectLockReqV2_VNU) ProtoMessage() {}
+
+func (x *GetObjectLockReqV2_VNU) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetObjectLockReqV2_VNU.ProtoReflect.Descriptor instead.
+func (*GetObjectLockReqV2_VNU) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetObjectLockReqV2_VNU) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *GetObjectLockReqV2_VNU) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *GetObjectLockReqV2_VNU) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *GetObjectLockReqV2_VNU) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type PutObjectRetentionReqV2_VNU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *PutObjectRetentionReqV2_VNU) Reset() {
+	*x = PutObjectRetentionReqV2_VNU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutObjectRetentionReqV2_VNU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectRetentionReqV2_VNU) ProtoMessage() {}
+
+func (x *PutObjectRetentionReqV2_VNU) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectRetentionReqV2_VNU.ProtoReflect.Descriptor instead.
+func (*PutObjectRetentionReqV2_VNU) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *PutObjectRetentionReqV2_VNU) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *PutObjectRetentionReqV2_VNU) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *PutObjectRetentionReqV2_VNU) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *PutObjectRetentionReqV2_VNU) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+type PutObjectLegalHoldReqV2_VNU struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp         *uint32 `protobuf:"varint,1,opt,name=timestamp" json:"timestamp,omitempty"`
+	MachineIdentifier *int32  `protobuf:"varint,2,opt,name=machineIdentifier" json:"machineIdentifier,omitempty"`
+	ProcessIdentifier *uint32 `protobuf:"varint,3,opt,name=processIdentifier" json:"processIdentifier,omitempty"`
+	Counter           *int32  `protobuf:"varint,4,opt,name=counter" json:"counter,omitempty"`
+}
+
+func (x *PutObjectLegalHoldReqV2_VNU) Reset() {
+	*x = PutObjectLegalHoldReqV2_VNU{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_s3_v2_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutObjectLegalHoldReqV2_VNU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutObjectLegalHoldReqV2_VNU) ProtoMessage() {}
+
+func (x *PutObjectLegalHoldReqV2_VNU) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_s3_v2_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutObjectLegalHoldReqV2_VNU.ProtoReflect.Descriptor instead.
+func (*PutObjectLegalHoldReqV2_VNU) Descriptor() ([]byte, []int) {
+	return file_msg_s3_v2_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *PutObjectLegalHoldReqV2_VNU) GetTimestamp() uint32 {
+	if x != nil && x.Timestamp != nil {
+		return *x.Timestamp
+	}
+	return 0
+}
+
+func (x *PutObjectLegalHoldReqV2_VNU) GetMachineIdentifier() int32 {
+	if x != nil && x.MachineIdentifier != nil {
+		return *x.MachineIdentifier
+	}
+	return 0
+}
+
+func (x *PutObjectLegalHoldReqV2_VNU) GetProcessIdentifier() uint32 {
+	if x != nil && x.ProcessIdentifier != nil {
+		return *x.ProcessIdentifier
+	}
+	return 0
+}
+
+func (x *PutObjectLegalHoldReqV2_VNU) GetCounter() int32 {
+	if x != nil && x.Counter != nil {
+		return *x.Counter
+	}
+	return 0
+}
+
+var File_msg_s3_v2_proto protoreflect.FileDescriptor
+
+var file_msg_s3_v2_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x6d, 0x73, 0x67, 0x2e, 0x73, 0x33, 0x2e, 0x76, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x03, 0x70, 0x6b, 0x74, 0x22, 0xff, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x70, 0x79, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x72, 0x63, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x72, 0x63, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x72,
+	0x63, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x73, 0x72, 0x63, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1e,
+	0x0a, 0x0a, 0x64, 0x65, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x6d, 0x65, 0x74, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa7, 0x03, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x56, 0x32,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62,
 	0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
 	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05,
@@ -1223,26 +2654,173 @@ var file_msg_s3_v2_proto_rawDesc = []byte{
 	0x0a, 0x03, 0x76, 0x6e, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x18, 0x2e, 0x70, 0x6b,
 	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x56,
 	0x32, 0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03, 0x76, 0x6e, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
-	0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x1a, 0x99,
-	0x01, 0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70,
-	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22,
-	0x9e, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x12, 0x2a,
+	0x0a, 0x10, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73,
+	0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x99, 0x01, 0x0a, 0x03, 0x56,
+	0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x71, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x01, 0x62, 0x22,
+	0xcd, 0x05, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x1c, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x49, 0x64, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x22, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x2e, 0x4e,
+	0x65, 0x78, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x0d, 0x6e, 0x65,
+	0x78, 0x74, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x65, 0x72, 0x1a, 0x9d, 0x01, 0x0a, 0x07, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x1a, 0xa3, 0x01, 0x0a, 0x0d, 0x4e, 0x65,
+	0x78, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22,
+	0x99, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65,
+	0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x22, 0xfb, 0x02, 0x0a, 0x0f,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x03, 0x76, 0x6e, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x18, 0x2e, 0x70, 0x6b, 0x74,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x56, 0x32,
+	0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03, 0x76, 0x6e, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74,
+	0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x1a, 0x99, 0x01,
+	0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0xf3, 0x02, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x30, 0x0a, 0x03, 0x76, 0x6e, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x1e,
+	0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x61,
+	0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x56, 0x32, 0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03,
+	0x76, 0x6e, 0x75, 0x1a, 0x99, 0x01, 0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22,
+	0x87, 0x03, 0x0a, 0x15, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a,
+	0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x03, 0x76, 0x6e, 0x75, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0a, 0x32, 0x1e, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x50, 0x75, 0x74, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x54, 0x61, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x56, 0x32,
+	0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03, 0x76, 0x6e, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x1a, 0x99, 0x01,
+	0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0xb5, 0x03, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71,
 	0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69,
 	0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69,
@@ -1250,39 +2828,39 @@ var file_msg_s3_v2_proto_rawDesc = []byte{
 	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75,
 	0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61,
 	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x71, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
-	0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
-	0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
-	0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x01, 0x62, 0x22, 0xaf, 0x05, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3b,
+	0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0a, 0x32,
+	0x21, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x42, 0x79, 0x54, 0x61, 0x67, 0x52, 0x65, 0x71, 0x56, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x49, 0x64, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x64, 0x1a, 0x9d, 0x01, 0x0a, 0x07,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x4a, 0x04, 0x08, 0x07, 0x10,
+	0x08, 0x22, 0xeb, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41,
+	0x63, 0x6c, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
 	0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b,
 	0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
 	0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63,
 	0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x1c, 0x2e, 0x70, 0x6b, 0x74,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32,
-	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x64, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x22, 0x2e, 0x70,
-	0x6b, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71,
-	0x56, 0x32, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x69, 0x64, 0x1a,
-	0x9d, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x76, 0x6e, 0x75, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0a, 0x32, 0x1a, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x56, 0x32, 0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03,
+	0x76, 0x6e, 0x75, 0x1a, 0x99, 0x01, 0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
 	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63,
 	0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02,
@@ -1291,42 +2869,45 @@ var file_msg_s3_v2_proto_rawDesc = []byte{
 	0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x1a,
-	0xa3, 0x01, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
-	0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a,
-	0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
-	0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a,
-	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d, 0x65, 0x74,
-	0x61, 0x22, 0xfb, 0x02, 0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22,
+	0xfd, 0x02, 0x0a, 0x11, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x6c,
 	0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a,
 	0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79,
 	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65,
 	0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
 	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x03, 0x76, 0x6e, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0a,
-	0x32, 0x18, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x56, 0x32, 0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03, 0x76, 0x6e, 0x75, 0x12,
-	0x12, 0x0a, 0x04, 0x6d, 0x65, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6d,
-	0x65, 0x74, 0x61, 0x1a, 0x99, 0x01, 0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x76, 0x6e, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0a,
+	0x32, 0x1a, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x41, 0x63, 0x6c, 0x52, 0x65, 0x71, 0x56, 0x32, 0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03, 0x76, 0x6e,
+	0x75, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x61, 0x63, 0x6c, 0x1a, 0x99, 0x01, 0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63,
+	0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22,
+	0xed, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b, 0x65,
+	0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6b,
+	0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x03, 0x76, 0x6e, 0x75, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0a, 0x32, 0x1b, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x56, 0x32, 0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03,
+	0x76, 0x6e, 0x75, 0x1a, 0x99, 0x01, 0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
 	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63,
 	0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02,
@@ -1335,7 +2916,74 @@ var file_msg_s3_v2_proto_rawDesc = []byte{
 	0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74,
 	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22,
+	0xd9, 0x03, 0x0a, 0x17, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x03, 0x76, 0x6e, 0x75,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0a, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x50, 0x75, 0x74,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x56, 0x32, 0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03, 0x76, 0x6e, 0x75, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x61, 0x69, 0x6e, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x79, 0x70, 0x61, 0x73, 0x73, 0x47, 0x6f, 0x76,
+	0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x62,
+	0x79, 0x70, 0x61, 0x73, 0x73, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a,
+	0x99, 0x01, 0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x22, 0x95, 0x03, 0x0a, 0x17,
+	0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f,
+	0x6c, 0x64, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6b,
+	0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x03, 0x76, 0x6e, 0x75, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0a, 0x32, 0x20, 0x2e, 0x70, 0x6b, 0x74, 0x2e, 0x50, 0x75, 0x74, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x4c, 0x65, 0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x56, 0x32,
+	0x2e, 0x56, 0x4e, 0x55, 0x52, 0x03, 0x76, 0x6e, 0x75, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x65, 0x67,
+	0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x65,
+	0x67, 0x61, 0x6c, 0x48, 0x6f, 0x6c, 0x64, 0x1a, 0x99, 0x01, 0x0a, 0x03, 0x56, 0x4e, 0x55, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a,
+	0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x68, 0x69, 0x6e,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x56, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
+	0x0a, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65,
 }
 
 var (
@@ -1350,7 +2998,7 @@ func file_msg_s3_v2_proto_rawDescGZIP() []byte {
 	return file_msg_s3_v2_proto_rawDescData
 }
 
-var file_msg_s3_v2_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_msg_s3_v2_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_msg_s3_v2_proto_goTypes = []interface{}{
 	(*CopyObjectReqV2)(nil),               // 0: pkt.CopyObjectReqV2
 	(*CreateBucketReqV2)(nil),             // 1: pkt.CreateBucketReqV2
@@ -1362,21 +3010,46 @@ var file_msg_s3_v2_proto_goTypes = []interface{}{
 	(*ListObjectReqV2)(nil),               // 7: pkt.ListObjectReqV2
 	(*UpdateBucketReqV2)(nil),             // 8: pkt.UpdateBucketReqV2
 	(*UploadFileReqV2)(nil),               // 9: pkt.UploadFileReqV2
-	(*DeleteFileReqV2_VNU)(nil),           // 10: pkt.DeleteFileReqV2.VNU
-	(*ListObjectReqV2_StartId)(nil),       // 11: pkt.ListObjectReqV2.StartId
-	(*ListObjectReqV2_NextVersionId)(nil), // 12: pkt.ListObjectReqV2.NextVersionId
-	(*UploadFileReqV2_VNU)(nil),           // 13: pkt.UploadFileReqV2.VNU
+	(*GetObjectTaggingReqV2)(nil),         // 10: pkt.GetObjectTaggingReqV2
+	(*PutObjectTaggingReqV2)(nil),         // 11: pkt.PutObjectTaggingReqV2
+	(*ListObjectByTagReqV2)(nil),          // 12: pkt.ListObjectByTagReqV2
+	(*GetObjectAclReqV2)(nil),             // 13: pkt.GetObjectAclReqV2
+	(*PutObjectAclReqV2)(nil),             // 14: pkt.PutObjectAclReqV2
+	(*GetObjectLockReqV2)(nil),            // 15: pkt.GetObjectLockReqV2
+	(*PutObjectRetentionReqV2)(nil),       // 16: pkt.PutObjectRetentionReqV2
+	(*PutObjectLegalHoldReqV2)(nil),       // 17: pkt.PutObjectLegalHoldReqV2
+	(*SetBucketMetaReqV2)(nil),            // 18: pkt.SetBucketMetaReqV2
+	(*DeleteFileReqV2_VNU)(nil),           // 19: pkt.DeleteFileReqV2.VNU
+	(*ListObjectReqV2_StartId)(nil),       // 20: pkt.ListObjectReqV2.StartId
+	(*ListObjectReqV2_NextVersionId)(nil), // 21: pkt.ListObjectReqV2.NextVersionId
+	(*UploadFileReqV2_VNU)(nil),           // 22: pkt.UploadFileReqV2.VNU
+	(*GetObjectTaggingReqV2_VNU)(nil),     // 23: pkt.GetObjectTaggingReqV2.VNU
+	(*PutObjectTaggingReqV2_VNU)(nil),     // 24: pkt.PutObjectTaggingReqV2.VNU
+	(*ListObjectByTagReqV2_StartId)(nil),  // 25: pkt.ListObjectByTagReqV2.StartId
+	(*GetObjectAclReqV2_VNU)(nil),         // 26: pkt.GetObjectAclReqV2.VNU
+	(*PutObjectAclReqV2_VNU)(nil),         // 27: pkt.PutObjectAclReqV2.VNU
+	(*GetObjectLockReqV2_VNU)(nil),        // 28: pkt.GetObjectLockReqV2.VNU
+	(*PutObjectRetentionReqV2_VNU)(nil),   // 29: pkt.PutObjectRetentionReqV2.VNU
+	(*PutObjectLegalHoldReqV2_VNU)(nil),   // 30: pkt.PutObjectLegalHoldReqV2.VNU
 }
 var file_msg_s3_v2_proto_depIdxs = []int32{
-	10, // 0: pkt.DeleteFileReqV2.vnu:type_name -> pkt.DeleteFileReqV2.VNU
-	11, // 1: pkt.ListObjectReqV2.startid:type_name -> pkt.ListObjectReqV2.StartId
-	12, // 2: pkt.ListObjectReqV2.nextversionid:type_name -> pkt.ListObjectReqV2.NextVersionId
-	13, // 3: pkt.UploadFileReqV2.vnu:type_name -> pkt.UploadFileReqV2.VNU
-	4,  // [4:4] is the sub-list for method output_type
-	4,  // [4:4] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	19, // 0: pkt.DeleteFileReqV2.vnu:type_name -> pkt.DeleteFileReqV2.VNU
+	20, // 1: pkt.ListObjectReqV2.startid:type_name -> pkt.ListObjectReqV2.StartId
+	21, // 2: pkt.ListObjectReqV2.nextversionid:type_name -> pkt.ListObjectReqV2.NextVersionId
+	22, // 3: pkt.UploadFileReqV2.vnu:type_name -> pkt.UploadFileReqV2.VNU
+	23, // 4: pkt.GetObjectTaggingReqV2.vnu:type_name -> pkt.GetObjectTaggingReqV2.VNU
+	24, // 5: pkt.PutObjectTaggingReqV2.vnu:type_name -> pkt.PutObjectTaggingReqV2.VNU
+	25, // 6: pkt.ListObjectByTagReqV2.startid:type_name -> pkt.ListObjectByTagReqV2.StartId
+	26, // 7: pkt.GetObjectAclReqV2.vnu:type_name -> pkt.GetObjectAclReqV2.VNU
+	27, // 8: pkt.PutObjectAclReqV2.vnu:type_name -> pkt.PutObjectAclReqV2.VNU
+	28, // 9: pkt.GetObjectLockReqV2.vnu:type_name -> pkt.GetObjectLockReqV2.VNU
+	29, // 10: pkt.PutObjectRetentionReqV2.vnu:type_name -> pkt.PutObjectRetentionReqV2.VNU
+	30, // 11: pkt.PutObjectLegalHoldReqV2.vnu:type_name -> pkt.PutObjectLegalHoldReqV2.VNU
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_msg_s3_v2_proto_init() }
@@ -1506,7 +3179,7 @@ func file_msg_s3_v2_proto_init() {
 			}
 		}
 		file_msg_s3_v2_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFileReqV2_VNU); i {
+			switch v := v.(*GetObjectTaggingReqV2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,7 +3191,7 @@ func file_msg_s3_v2_proto_init() {
 			}
 		}
 		file_msg_s3_v2_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListObjectReqV2_StartId); i {
+			switch v := v.(*PutObjectTaggingReqV2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,7 +3203,7 @@ func file_msg_s3_v2_proto_init() {
 			}
 		}
 		file_msg_s3_v2_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListObjectReqV2_NextVersionId); i {
+			switch v := v.(*ListObjectByTagReqV2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1542,6 +3215,114 @@ func file_msg_s3_v2_proto_init() {
 			}
 		}
 		file_msg_s3_v2_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectAclReqV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutObjectAclReqV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectLockReqV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutObjectRetentionReqV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutObjectLegalHoldReqV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetBucketMetaReqV2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFileReqV2_VNU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListObjectReqV2_StartId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListObjectReqV2_NextVersionId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UploadFileReqV2_VNU); i {
 			case 0:
 				return &v.state
@@ -1553,6 +3334,102 @@ func file_msg_s3_v2_proto_init() {
 				return nil
 			}
 		}
+		file_msg_s3_v2_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectTaggingReqV2_VNU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutObjectTaggingReqV2_VNU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListObjectByTagReqV2_StartId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectAclReqV2_VNU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutObjectAclReqV2_VNU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetObjectLockReqV2_VNU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutObjectRetentionReqV2_VNU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_s3_v2_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutObjectLegalHoldReqV2_VNU); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1560,7 +3437,7 @@ func file_msg_s3_v2_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_msg_s3_v2_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
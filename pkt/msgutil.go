@@ -127,7 +127,8 @@ func MarshalMap(m map[string]string) ([]byte, error) {
 	vs := []*StringMap_Vals{}
 	for k, v := range m {
 		keys = append(keys, k)
-		nv := &StringMap_Vals{Val: &v}
+		val := v
+		nv := &StringMap_Vals{Val: &val}
 		vs = append(vs, nv)
 	}
 	msg := &StringMap{Keys: keys, Vals: vs}
@@ -0,0 +1,160 @@
+package pkt
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const BucketMetaNotification = "notification"
+
+const (
+	EventObjectCreatedPut    = "s3:ObjectCreated:Put"
+	EventObjectCreatedCopy   = "s3:ObjectCreated:Copy"
+	EventObjectRemovedDelete = "s3:ObjectRemoved:Delete"
+)
+
+// NotificationConfiguration posts S3 event records to HTTP webhooks when
+// objects of the bucket are created or deleted. It is kept in bucket meta;
+// the gateway converts it from the S3 XML document.
+type NotificationConfiguration struct {
+	Webhooks []*WebhookConfiguration `json:"webhooks"`
+}
+
+type WebhookConfiguration struct {
+	ID       string              `json:"id,omitempty"`
+	Endpoint string              `json:"endpoint"`
+	Events   []string            `json:"events"`
+	Filter   *NotificationFilter `json:"filter,omitempty"`
+}
+
+type NotificationFilter struct {
+	Rules []*FilterRule `json:"rules"`
+}
+
+type FilterRule struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+func ParseNotification(data []byte) (*NotificationConfiguration, error) {
+	conf := &NotificationConfiguration{}
+	if err := json.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func (c *NotificationConfiguration) Marshal() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+func (w *WebhookConfiguration) Matches(event, key string) bool {
+	matched := false
+	for _, e := range w.Events {
+		if e == event || strings.HasSuffix(e, ":*") && strings.HasPrefix(event, strings.TrimSuffix(e, "*")) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return false
+	}
+	if w.Filter != nil {
+		for _, r := range w.Filter.Rules {
+			switch strings.ToLower(r.Name) {
+			case "prefix":
+				if !strings.HasPrefix(key, r.Value) {
+					return false
+				}
+			case "suffix":
+				if !strings.HasSuffix(key, r.Value) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// NotificationFromMeta returns the notification configuration kept in
+// bucket meta, or nil if there is none.
+func NotificationFromMeta(meta []byte) *NotificationConfiguration {
+	if len(meta) == 0 {
+		return nil
+	}
+	m, err := UnmarshalMap(meta)
+	if err != nil {
+		return nil
+	}
+	s, ok := m[BucketMetaNotification]
+	if !ok || s == "" {
+		return nil
+	}
+	conf, err := ParseNotification([]byte(s))
+	if err != nil || len(conf.Webhooks) == 0 {
+		return nil
+	}
+	return conf
+}
+
+// Event is the body posted to webhooks, laid out like S3 event messages.
+type Event struct {
+	Records []*EventRecord `json:"Records"`
+}
+
+type EventRecord struct {
+	EventVersion string        `json:"eventVersion"`
+	EventSource  string        `json:"eventSource"`
+	EventTime    string        `json:"eventTime"`
+	EventName    string        `json:"eventName"`
+	UserIdentity EventIdentity `json:"userIdentity"`
+	S3           EventS3       `json:"s3"`
+}
+
+type EventIdentity struct {
+	PrincipalID string `json:"principalId"`
+}
+
+type EventS3 struct {
+	SchemaVersion   string      `json:"s3SchemaVersion"`
+	ConfigurationID string      `json:"configurationId"`
+	Bucket          EventBucket `json:"bucket"`
+	Object          EventObject `json:"object"`
+}
+
+type EventBucket struct {
+	Name          string        `json:"name"`
+	OwnerIdentity EventIdentity `json:"ownerIdentity"`
+	Arn           string        `json:"arn"`
+}
+
+type EventObject struct {
+	Key       string `json:"key"`
+	Size      int64  `json:"size,omitempty"`
+	ETag      string `json:"eTag,omitempty"`
+	VersionID string `json:"versionId,omitempty"`
+	Sequencer string `json:"sequencer"`
+}
+
+// NewEvent builds the message for one object event. The key is URL encoded
+// as in S3 event messages.
+func NewEvent(configID, event, owner, bucket, key string, size int64, etag, versionID string, at time.Time) ([]byte, error) {
+	rec := &EventRecord{
+		EventVersion: "2.1",
+		EventSource:  "yotta:s3",
+		EventTime:    at.UTC().Format("2006-01-02T15:04:05.000Z"),
+		EventName:    strings.TrimPrefix(event, "s3:"),
+		UserIdentity: EventIdentity{PrincipalID: owner},
+		S3: EventS3{
+			SchemaVersion:   "1.0",
+			ConfigurationID: configID,
+			Bucket:          EventBucket{Name: bucket, OwnerIdentity: EventIdentity{PrincipalID: owner}, Arn: "arn:aws:s3:::" + bucket},
+			Object: EventObject{Key: url.QueryEscape(key), Size: size, ETag: etag, VersionID: versionID,
+				Sequencer: fmt.Sprintf("%016X", at.UnixNano())},
+		},
+	}
+	return json.Marshal(&Event{Records: []*EventRecord{rec}})
+}
@@ -0,0 +1,184 @@
+package pkt
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const BucketMetaObjectLock = "objectlock"
+
+const (
+	LockModeGovernance = "GOVERNANCE"
+	LockModeCompliance = "COMPLIANCE"
+)
+
+// The lock of an object version travels in its file meta under these keys.
+const (
+	ObjectLockModeKey        = "X-Amz-Object-Lock-Mode"
+	ObjectLockRetainUntilKey = "X-Amz-Object-Lock-Retain-Until-Date"
+	ObjectLockLegalHoldKey   = "X-Amz-Object-Lock-Legal-Hold"
+)
+
+// ObjectLockConfiguration is the object lock of a bucket as kept in its
+// meta. The gateway converts it from the S3 XML document.
+type ObjectLockConfiguration struct {
+	ObjectLockEnabled string          `json:"objectLockEnabled,omitempty"`
+	Rule              *ObjectLockRule `json:"rule,omitempty"`
+}
+
+type ObjectLockRule struct {
+	DefaultRetention *DefaultRetention `json:"defaultRetention"`
+}
+
+type DefaultRetention struct {
+	Mode  string `json:"mode"`
+	Days  int    `json:"days,omitempty"`
+	Years int    `json:"years,omitempty"`
+}
+
+func ParseObjectLockConfiguration(data []byte) (*ObjectLockConfiguration, error) {
+	conf := &ObjectLockConfiguration{}
+	if err := json.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
+
+func (c *ObjectLockConfiguration) Marshal() ([]byte, error) {
+	return json.Marshal(c)
+}
+
+// Validate checks the configuration as the SN stores it; object lock can
+// only ever be Enabled.
+func (c *ObjectLockConfiguration) Validate() error {
+	if c.ObjectLockEnabled != "Enabled" {
+		return errors.New("object lock can only be enabled")
+	}
+	if c.Rule == nil {
+		return nil
+	}
+	r := c.Rule.DefaultRetention
+	if r == nil {
+		return errors.New("object lock rule without default retention")
+	}
+	if !ValidLockMode(r.Mode) {
+		return errors.New("invalid object lock mode")
+	}
+	if r.Days < 0 || r.Years < 0 || (r.Days > 0) == (r.Years > 0) {
+		return errors.New("default retention requires either days or years")
+	}
+	return nil
+}
+
+// DefaultLock returns the lock applied to versions written at now without
+// an explicit retention, or nil if the bucket has no default rule.
+func (c *ObjectLockConfiguration) DefaultLock(now time.Time) *ObjectLock {
+	if c.Rule == nil || c.Rule.DefaultRetention == nil {
+		return nil
+	}
+	r := c.Rule.DefaultRetention
+	until := now.AddDate(r.Years, 0, r.Days)
+	return &ObjectLock{Mode: r.Mode, RetainUntil: until.Unix()}
+}
+
+// ObjectLockFromMeta returns the object lock configuration kept in bucket
+// meta, or nil if object lock is not enabled on the bucket.
+func ObjectLockFromMeta(meta []byte) *ObjectLockConfiguration {
+	if len(meta) == 0 {
+		return nil
+	}
+	m, err := UnmarshalMap(meta)
+	if err != nil {
+		return nil
+	}
+	s, ok := m[BucketMetaObjectLock]
+	if !ok || s == "" {
+		return nil
+	}
+	conf, err := ParseObjectLockConfiguration([]byte(s))
+	if err != nil || conf.ObjectLockEnabled != "Enabled" {
+		return nil
+	}
+	return conf
+}
+
+func ValidLockMode(mode string) bool {
+	return mode == LockModeGovernance || mode == LockModeCompliance
+}
+
+// ObjectLock is the retention and legal hold of one object version.
+type ObjectLock struct {
+	Mode        string
+	RetainUntil int64
+	LegalHold   bool
+}
+
+// Locked reports whether the version may not be deleted or overwritten at
+// now. Governance retention yields to bypass, compliance never does.
+func (l *ObjectLock) Locked(now time.Time, bypass bool) bool {
+	if l == nil {
+		return false
+	}
+	if l.LegalHold {
+		return true
+	}
+	if l.RetainUntil <= now.Unix() {
+		return false
+	}
+	return !(bypass && l.Mode == LockModeGovernance)
+}
+
+// ObjectLockFromMap parses the lock keys of a file meta map, returning nil
+// if there are none.
+func ObjectLockFromMap(m map[string]string) (*ObjectLock, error) {
+	mode, until, hold := m[ObjectLockModeKey], m[ObjectLockRetainUntilKey], m[ObjectLockLegalHoldKey]
+	if mode == "" && until == "" && hold == "" {
+		return nil, nil
+	}
+	l := &ObjectLock{}
+	if mode != "" || until != "" {
+		mode = strings.ToUpper(mode)
+		if !ValidLockMode(mode) {
+			return nil, errors.New("invalid object lock mode")
+		}
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return nil, errors.New("invalid retain until date")
+		}
+		l.Mode, l.RetainUntil = mode, t.Unix()
+	}
+	switch strings.ToUpper(hold) {
+	case "", "OFF":
+	case "ON":
+		l.LegalHold = true
+	default:
+		return nil, errors.New("invalid legal hold status")
+	}
+	return l, nil
+}
+
+// SetMap writes the lock into a file meta map.
+func (l *ObjectLock) SetMap(m map[string]string) {
+	delete(m, ObjectLockModeKey)
+	delete(m, ObjectLockRetainUntilKey)
+	delete(m, ObjectLockLegalHoldKey)
+	if l == nil {
+		return
+	}
+	if l.Mode != "" {
+		m[ObjectLockModeKey] = l.Mode
+		m[ObjectLockRetainUntilKey] = l.RetainUntilDate()
+	}
+	if l.LegalHold {
+		m[ObjectLockLegalHoldKey] = "ON"
+	}
+}
+
+func (l *ObjectLock) RetainUntilDate() string {
+	if l.RetainUntil == 0 {
+		return ""
+	}
+	return time.Unix(l.RetainUntil, 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,174 @@
+package pkt
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+const BucketMetaQuota = "quota"
+
+// BucketQuota caps the bytes and the number of object versions stored in a
+// bucket. Zero means unlimited.
+type BucketQuota struct {
+	MaxSize    int64 `json:"maxSizeBytes,omitempty"`
+	MaxObjects int64 `json:"maxObjects,omitempty"`
+}
+
+func ParseBucketQuota(data []byte) (*BucketQuota, error) {
+	quota := &BucketQuota{}
+	if err := json.Unmarshal(data, quota); err != nil {
+		return nil, err
+	}
+	if err := quota.Validate(); err != nil {
+		return nil, err
+	}
+	return quota, nil
+}
+
+func (q *BucketQuota) Marshal() ([]byte, error) {
+	return json.Marshal(q)
+}
+
+func (q *BucketQuota) Validate() error {
+	if q.MaxSize < 0 || q.MaxObjects < 0 {
+		return errors.New("quota values must not be negative")
+	}
+	return nil
+}
+
+// Allows reports whether an object of length bytes fits into a bucket
+// already holding count objects of size bytes in total.
+func (q *BucketQuota) Allows(size, count, length int64) bool {
+	if q.MaxObjects > 0 && count+1 > q.MaxObjects {
+		return false
+	}
+	if q.MaxSize > 0 && size+length > q.MaxSize {
+		return false
+	}
+	return true
+}
+
+// BucketQuotaFromMeta returns the quota kept in bucket meta, or nil if the
+// bucket is unlimited.
+func BucketQuotaFromMeta(meta []byte) *BucketQuota {
+	if len(meta) == 0 {
+		return nil
+	}
+	m, err := UnmarshalMap(meta)
+	if err != nil {
+		return nil
+	}
+	s, ok := m[BucketMetaQuota]
+	if !ok || s == "" {
+		return nil
+	}
+	quota, err := ParseBucketQuota([]byte(s))
+	if err != nil || (quota.MaxSize == 0 && quota.MaxObjects == 0) {
+		return nil
+	}
+	return quota
+}
+
+// keptBucketMeta are the keys an UpdateBucket never takes from the owner:
+// the quota is set by the operator, and the object lock only through
+// SetBucketMeta, which never lets it be dropped.
+var keptBucketMeta = []string{BucketMetaQuota, BucketMetaObjectLock}
+
+// KeepBucketMeta returns meta carrying the quota and object lock stored in
+// old instead of whatever the owner sent, and refuses a versioning change
+// CheckVersioning does not allow.
+func KeepBucketMeta(old, meta []byte) ([]byte, error) {
+	kept, err := UnmarshalMap(old)
+	if err != nil {
+		kept = make(map[string]string)
+	}
+	m, err := UnmarshalMap(meta)
+	if err != nil {
+		m = make(map[string]string)
+	}
+	if err := CheckVersioning(kept[BucketMetaVersioning], m[BucketMetaVersioning]); err != nil {
+		return nil, err
+	}
+	changed := false
+	for _, key := range keptBucketMeta {
+		v, ok := kept[key]
+		if w, has := m[key]; has == ok && w == v {
+			continue
+		}
+		changed = true
+		if ok {
+			m[key] = v
+		} else {
+			delete(m, key)
+		}
+	}
+	if !changed {
+		return meta, nil
+	}
+	return MarshalMap(m)
+}
+
+// SetBucketMeta returns meta with key set to value, or removed if value is
+// nil, for an owner changing one bucket setting. Enabling object lock also
+// enables versioning, and once enabled neither can be turned off.
+func SetBucketMeta(meta []byte, key string, value *string) ([]byte, error) {
+	if key == "" {
+		return nil, errors.New("empty bucket meta key")
+	}
+	if key == BucketMetaQuota {
+		return nil, errors.New("bucket quota is set by the operator")
+	}
+	m, err := UnmarshalMap(meta)
+	if err != nil {
+		m = make(map[string]string)
+	}
+	if key == BucketMetaVersioning {
+		status := ""
+		if value != nil {
+			status = *value
+		}
+		if err := CheckVersioning(m[key], status); err != nil {
+			return nil, err
+		}
+	}
+	if key == BucketMetaObjectLock && value == nil {
+		if _, ok := m[key]; ok {
+			return nil, errors.New("object lock can not be disabled")
+		}
+	}
+	if key == BucketMetaObjectLock && value != nil {
+		conf, err := ParseObjectLockConfiguration([]byte(*value))
+		if err != nil {
+			return nil, err
+		}
+		if err := conf.Validate(); err != nil {
+			return nil, err
+		}
+		m[BucketMetaVersioning] = VersioningEnabled
+	}
+	if value == nil {
+		delete(m, key)
+	} else {
+		m[key] = *value
+	}
+	return MarshalMap(m)
+}
+
+// SetBucketQuota returns meta with quota stored in it; a nil or unlimited
+// quota removes it.
+func SetBucketQuota(meta []byte, quota *BucketQuota) ([]byte, error) {
+	m, err := UnmarshalMap(meta)
+	if err != nil {
+		m = make(map[string]string)
+	}
+	if quota == nil || (quota.MaxSize == 0 && quota.MaxObjects == 0) {
+		delete(m, BucketMetaQuota)
+	} else {
+		bs, err := quota.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		m[BucketMetaQuota] = string(bs)
+	}
+	return MarshalMap(m)
+}
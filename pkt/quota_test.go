@@ -0,0 +1,122 @@
+package pkt
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testBucketMeta(t *testing.T, m map[string]string) []byte {
+	if m == nil {
+		return nil
+	}
+	bs, err := MarshalMap(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bs
+}
+
+func testBucketMetaMap(t *testing.T, bs []byte) map[string]string {
+	m, err := UnmarshalMap(bs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return m
+}
+
+const testObjectLock = `{"objectLockEnabled":"Enabled","rule":{"defaultRetention":{"mode":"COMPLIANCE","days":1}}}`
+
+func TestKeepBucketMeta(t *testing.T) {
+	locked := map[string]string{"acl": "public-read", BucketMetaObjectLock: testObjectLock, BucketMetaVersioning: VersioningEnabled}
+	tests := []struct {
+		name string
+		old  map[string]string
+		meta map[string]string
+		kept map[string]string
+	}{
+		{"no settings", nil, map[string]string{"acl": "private"}, map[string]string{"acl": "private"}},
+		{"quota kept", map[string]string{BucketMetaQuota: `{"maxObjects":10}`}, map[string]string{"acl": "private"},
+			map[string]string{"acl": "private", BucketMetaQuota: `{"maxObjects":10}`}},
+		{"quota not set by owner", nil, map[string]string{BucketMetaQuota: `{"maxObjects":10}`}, map[string]string{}},
+		{"lock kept", locked, map[string]string{"acl": "private", BucketMetaVersioning: VersioningEnabled},
+			map[string]string{"acl": "private", BucketMetaObjectLock: testObjectLock, BucketMetaVersioning: VersioningEnabled}},
+		{"lock not changed", locked, map[string]string{BucketMetaObjectLock: `{"objectLockEnabled":"Disabled"}`, BucketMetaVersioning: VersioningEnabled},
+			map[string]string{BucketMetaObjectLock: testObjectLock, BucketMetaVersioning: VersioningEnabled}},
+		{"lock not set by update", nil, map[string]string{BucketMetaObjectLock: testObjectLock}, map[string]string{}},
+		{"enable versioning", nil, map[string]string{BucketMetaVersioning: VersioningEnabled},
+			map[string]string{BucketMetaVersioning: VersioningEnabled}},
+		{"suspend versioning", map[string]string{BucketMetaVersioning: VersioningEnabled},
+			map[string]string{BucketMetaVersioning: "Suspended"}, nil},
+		{"clear versioning", map[string]string{BucketMetaVersioning: VersioningEnabled}, map[string]string{"acl": "private"}, nil},
+		{"suspend locked versioning", locked, map[string]string{BucketMetaObjectLock: testObjectLock, BucketMetaVersioning: "Suspended"}, nil},
+		{"clear locked versioning", locked, map[string]string{"acl": "public-read"}, nil},
+		{"create suspended", nil, map[string]string{BucketMetaVersioning: "Suspended"}, nil},
+	}
+	for _, tt := range tests {
+		bs, err := KeepBucketMeta(testBucketMeta(t, tt.old), testBucketMeta(t, tt.meta))
+		if (err != nil) != (tt.kept == nil) {
+			t.Errorf("%s: error %v", tt.name, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if m := testBucketMetaMap(t, bs); !reflect.DeepEqual(m, tt.kept) {
+			t.Errorf("%s: got %v", tt.name, m)
+		}
+	}
+}
+
+func TestSetBucketMeta(t *testing.T) {
+	value := func(s string) *string { return &s }
+	stored := map[string]string{"acl": "public-read", "policy": "{}", BucketMetaQuota: `{"maxObjects":10}`}
+	locked := map[string]string{BucketMetaObjectLock: testObjectLock, BucketMetaVersioning: VersioningEnabled}
+	tests := []struct {
+		name  string
+		old   map[string]string
+		key   string
+		value *string
+		meta  map[string]string
+		err   bool
+	}{
+		{"set", stored, "website", value("<x/>"),
+			map[string]string{"acl": "public-read", "policy": "{}", "website": "<x/>", BucketMetaQuota: `{"maxObjects":10}`}, false},
+		{"replace", stored, "acl", value("private"),
+			map[string]string{"acl": "private", "policy": "{}", BucketMetaQuota: `{"maxObjects":10}`}, false},
+		{"delete", stored, "policy", nil, map[string]string{"acl": "public-read", BucketMetaQuota: `{"maxObjects":10}`}, false},
+		{"delete missing", stored, "website", nil, stored, false},
+		{"empty meta", nil, "acl", value("public-read"), map[string]string{"acl": "public-read"}, false},
+		{"quota", stored, BucketMetaQuota, value("{}"), nil, true},
+		{"delete quota", stored, BucketMetaQuota, nil, nil, true},
+		{"empty key", stored, "", value("x"), nil, true},
+		{"enable lock", stored, BucketMetaObjectLock, value(testObjectLock),
+			map[string]string{"acl": "public-read", "policy": "{}", BucketMetaQuota: `{"maxObjects":10}`,
+				BucketMetaObjectLock: testObjectLock, BucketMetaVersioning: VersioningEnabled}, false},
+		{"change lock rule", locked, BucketMetaObjectLock, value(`{"objectLockEnabled":"Enabled"}`),
+			map[string]string{BucketMetaObjectLock: `{"objectLockEnabled":"Enabled"}`, BucketMetaVersioning: VersioningEnabled}, false},
+		{"disable lock", locked, BucketMetaObjectLock, value(`{"objectLockEnabled":"Disabled"}`), nil, true},
+		{"delete lock", locked, BucketMetaObjectLock, nil, nil, true},
+		{"delete missing lock", stored, BucketMetaObjectLock, nil, stored, false},
+		{"invalid lock", stored, BucketMetaObjectLock, value(`{"objectLockEnabled":"Enabled","rule":{"defaultRetention":{"mode":"NONE","days":1}}}`), nil, true},
+		{"lock json", stored, BucketMetaObjectLock, value(`{`), nil, true},
+		{"enable versioning", stored, BucketMetaVersioning, value(VersioningEnabled),
+			map[string]string{"acl": "public-read", "policy": "{}", BucketMetaQuota: `{"maxObjects":10}`, BucketMetaVersioning: VersioningEnabled}, false},
+		{"enable versioning again", locked, BucketMetaVersioning, value(VersioningEnabled), locked, false},
+		{"suspend versioning", locked, BucketMetaVersioning, value("Suspended"), nil, true},
+		{"delete versioning", locked, BucketMetaVersioning, nil, nil, true},
+		{"suspend unversioned", stored, BucketMetaVersioning, value("Suspended"), nil, true},
+	}
+	for _, tt := range tests {
+		bs, err := SetBucketMeta(testBucketMeta(t, tt.old), tt.key, tt.value)
+		if (err != nil) != tt.err {
+			t.Errorf("%s: error %v", tt.name, err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if m := testBucketMetaMap(t, bs); !reflect.DeepEqual(m, tt.meta) {
+			t.Errorf("%s: got %v", tt.name, m)
+		}
+	}
+}
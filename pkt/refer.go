@@ -31,10 +31,10 @@ func ParseRefers(blks [][]byte) []*Refer {
 func ToOldBlockList(ls [][]byte) {
 	for index, bs := range ls {
 		size := len(bs)
-		if size == 55 {
+		if size == 55 || size == 56 {
 			ls[index] = bs[0:54]
 		}
-		if size == 168 {
+		if size == 168 || size == 169 {
 			ls[index] = bs[0:167]
 		}
 	}
@@ -69,6 +69,8 @@ func MergeRefers(ls []*Refer) [][]byte {
 	return blks
 }
 
+// Format is the codec block format, 0 for refers recorded before formats
+// were; only non-zero formats are serialized, as a trailing byte.
 type Refer struct {
 	VBI          int64
 	Dup          uint8
@@ -78,6 +80,7 @@ type Refer struct {
 	KeyNumber    int16
 	Id           int16
 	ShdCount     uint8
+	Format       uint8
 }
 
 func NewRefer(bs []byte) *Refer {
@@ -85,7 +88,7 @@ func NewRefer(bs []byte) *Refer {
 		return nil
 	}
 	size := len(bs)
-	if !(size == 54 || size == 167 || size == 55 || size == 168) {
+	if !(size == 54 || size == 167 || size == 55 || size == 168 || size == 56 || size == 169) {
 		return nil
 	}
 	vbi := int64(bs[0] & 0xFF)
@@ -106,26 +109,32 @@ func NewRefer(bs []byte) *Refer {
 	realSize := int32(bs[15] & 0xFF)
 	realSize = realSize<<8 | int32(bs[16]&0xFF)
 	realSize = realSize<<8 | int32(bs[17]&0xFF)
-	if size == 54 || size == 55 {
+	if size == 54 || size == 55 || size == 56 {
 		keu := bs[18 : 18+32]
 		id := int16(bs[50] & 0xFF)
 		id = id<<8 | int16(bs[51]&0xFF)
 		KeyNumber := int16(bs[52] & 0xFF)
 		KeyNumber = KeyNumber<<8 | int16(bs[53]&0xFF)
+		if size == 56 {
+			return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, uint8(bs[54]), uint8(bs[55])}
+		}
 		if size == 55 {
-			return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, uint8(bs[54])}
+			return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, uint8(bs[54]), 0}
 		}
-		return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, 0}
+		return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, 0, 0}
 	} else {
 		keu := bs[18 : 18+145]
 		id := int16(bs[18+145] & 0xFF)
 		id = id<<8 | int16(bs[18+145+1]&0xFF)
 		KeyNumber := int16(bs[18+145+2] & 0xFF)
 		KeyNumber = KeyNumber<<8 | int16(bs[18+145+3]&0xFF)
+		if size == 169 {
+			return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, uint8(bs[167]), uint8(bs[168])}
+		}
 		if size == 168 {
-			return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, uint8(bs[167])}
+			return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, uint8(bs[167]), 0}
 		}
-		return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, 0}
+		return &Refer{vbi, dup, originalSize, realSize, keu, KeyNumber, id, 0, 0}
 	}
 }
 
@@ -155,5 +164,8 @@ func (ref *Refer) Bytes() []byte {
 	bs1[2] = uint8(ref.KeyNumber >> 8)
 	bs1[3] = uint8(ref.KeyNumber)
 	bs1[4] = ref.ShdCount
+	if ref.Format != 0 {
+		bs1 = append(bs1, ref.Format)
+	}
 	return bytes.Join([][]byte{bs, ref.KEU, bs1}, []byte(""))
 }
@@ -0,0 +1,35 @@
+package pkt
+
+import "errors"
+
+const BucketMetaVersioning = "versioning"
+
+// VersioningEnabled is the only status a bucket can be set to, as the SN
+// keeps every write as a version.
+const VersioningEnabled = "Enabled"
+
+// BucketVersioning returns the versioning status kept in bucket meta, empty
+// if versioning was never enabled.
+func BucketVersioning(meta []byte) string {
+	if len(meta) == 0 {
+		return ""
+	}
+	m, err := UnmarshalMap(meta)
+	if err != nil {
+		return ""
+	}
+	return m[BucketMetaVersioning]
+}
+
+// CheckVersioning refuses changing the versioning status of a bucket from
+// old to status. Versioning can be enabled, but never suspended or cleared
+// again, which object lock relies on.
+func CheckVersioning(old, status string) error {
+	if status == old || status == VersioningEnabled {
+		return nil
+	}
+	if old == "" {
+		return errors.New("versioning can only be enabled")
+	}
+	return errors.New("versioning can not be suspended once enabled")
+}
@@ -0,0 +1,279 @@
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/api/cache"
+)
+
+type CannedACL string
+
+const (
+	ACLPrivate           CannedACL = "private"
+	ACLPublicRead        CannedACL = "public-read"
+	ACLAuthenticatedRead CannedACL = "authenticated-read"
+
+	groupAllUsers           = "http://acs.amazonaws.com/groups/global/AllUsers"
+	groupAuthenticatedUsers = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+)
+
+func ParseCannedACL(s string) (CannedACL, error) {
+	switch CannedACL(s) {
+	case "", ACLPrivate:
+		return ACLPrivate, nil
+	case ACLPublicRead, ACLAuthenticatedRead:
+		return CannedACL(s), nil
+	default:
+		return "", ErrorInvalidArgument("x-amz-acl", s, "Unsupported canned ACL")
+	}
+}
+
+// GrantsRead reports whether the ACL lets principal read; an empty principal
+// is an anonymous request.
+func (acl CannedACL) GrantsRead(principal string) bool {
+	switch acl {
+	case ACLPublicRead:
+		return true
+	case ACLAuthenticatedRead:
+		return principal != ""
+	default:
+		return false
+	}
+}
+
+// AccessControlBackend stores canned ACLs and bucket policies. Policies are
+// kept as the JSON document supplied by the owner.
+type AccessControlBackend interface {
+	BucketACL(accesskey, bucket string) (CannedACL, error)
+
+	SetBucketACL(accesskey, bucket string, acl CannedACL) error
+
+	ObjectACL(accesskey, bucket, object string, versionID VersionID) (CannedACL, error)
+
+	SetObjectACL(accesskey, bucket, object string, versionID VersionID, acl CannedACL) error
+
+	BucketPolicy(accesskey, bucket string) ([]byte, error)
+
+	SetBucketPolicy(accesskey, bucket string, policy []byte) error
+
+	DeleteBucketPolicy(accesskey, bucket string) error
+}
+
+type AccessControlPolicy struct {
+	XMLName           xml.Name  `xml:"AccessControlPolicy"`
+	Xmlns             string    `xml:"xmlns,attr,omitempty"`
+	Owner             *UserInfo `xml:"Owner,omitempty"`
+	AccessControlList []Grant   `xml:"AccessControlList>Grant"`
+}
+
+type Grant struct {
+	Grantee    Grantee `xml:"Grantee"`
+	Permission string  `xml:"Permission"`
+}
+
+type Grantee struct {
+	Xsi         string `xml:"xmlns:xsi,attr,omitempty"`
+	Type        string `xml:"xsi:type,attr,omitempty"`
+	ID          string `xml:"ID,omitempty"`
+	DisplayName string `xml:"DisplayName,omitempty"`
+	URI         string `xml:"URI,omitempty"`
+}
+
+func newAccessControlPolicy(owner string, acl CannedACL) *AccessControlPolicy {
+	user := &UserInfo{ID: accessKeyPrefix + owner, DisplayName: accessKeyPrefix + owner}
+	policy := &AccessControlPolicy{
+		Xmlns: "http://s3.amazonaws.com/doc/2006-03-01/",
+		Owner: user,
+		AccessControlList: []Grant{{
+			Grantee:    Grantee{Xsi: "http://www.w3.org/2001/XMLSchema-instance", Type: "CanonicalUser", ID: user.ID, DisplayName: user.DisplayName},
+			Permission: "FULL_CONTROL",
+		}},
+	}
+	group := ""
+	switch acl {
+	case ACLPublicRead:
+		group = groupAllUsers
+	case ACLAuthenticatedRead:
+		group = groupAuthenticatedUsers
+	}
+	if group != "" {
+		policy.AccessControlList = append(policy.AccessControlList, Grant{
+			Grantee:    Grantee{Xsi: "http://www.w3.org/2001/XMLSchema-instance", Type: "Group", URI: group},
+			Permission: "READ",
+		})
+	}
+	return policy
+}
+
+// cannedACL maps an AccessControlPolicy body onto the closest canned ACL;
+// grants other than READ for the two global groups are rejected.
+func (p *AccessControlPolicy) cannedACL() (CannedACL, error) {
+	acl := ACLPrivate
+	for _, grant := range p.AccessControlList {
+		switch {
+		case grant.Grantee.URI == groupAllUsers && grant.Permission == "READ":
+			acl = ACLPublicRead
+		case grant.Grantee.URI == groupAuthenticatedUsers && grant.Permission == "READ":
+			if acl != ACLPublicRead {
+				acl = ACLAuthenticatedRead
+			}
+		case grant.Grantee.URI == "" && grant.Permission == "FULL_CONTROL":
+		default:
+			return "", ErrMalformedACLError
+		}
+	}
+	return acl, nil
+}
+
+var accessCache = gocache.New(30*time.Second, time.Minute)
+
+func (g *Server) bucketACL(owner, bucket string) CannedACL {
+	key := "acl/" + owner + "/" + bucket
+	if v, ok := accessCache.Get(key); ok {
+		return v.(CannedACL)
+	}
+	acl, err := g.acl.BucketACL(owner, bucket)
+	if err != nil {
+		logrus.Warnf("[S3]Get bucket acl %s ERR:%s\n", bucket, err)
+		return ACLPrivate
+	}
+	accessCache.SetDefault(key, acl)
+	return acl
+}
+
+func objectACLKey(owner, bucket, object string, versionID VersionID) string {
+	return "obj/" + owner + "/" + bucket + "/" + object + "?" + string(versionID)
+}
+
+func (g *Server) objectACL(owner, bucket, object string, versionID VersionID) CannedACL {
+	key := objectACLKey(owner, bucket, object, versionID)
+	if v, ok := accessCache.Get(key); ok {
+		return v.(CannedACL)
+	}
+	acl, err := g.acl.ObjectACL(owner, bucket, object, versionID)
+	if err != nil {
+		return ACLPrivate
+	}
+	accessCache.SetDefault(key, acl)
+	return acl
+}
+
+func (g *Server) bucketPolicy(owner, bucket string) *BucketPolicy {
+	key := "policy/" + owner + "/" + bucket
+	if v, ok := accessCache.Get(key); ok {
+		return v.(*BucketPolicy)
+	}
+	var policy *BucketPolicy
+	data, err := g.acl.BucketPolicy(owner, bucket)
+	if err == nil {
+		policy, err = ParseBucketPolicy(bucket, data)
+	}
+	if err != nil && !HasErrorCode(err, ErrNoSuchBucketPolicy) {
+		logrus.Warnf("[S3]Get bucket policy %s ERR:%s\n", bucket, err)
+	}
+	accessCache.SetDefault(key, policy)
+	return policy
+}
+
+// accessChanged drops cached ACL, policy and website state and records the owner of the
+// bucket so requests from other users can be resolved to it.
+func (g *Server) accessChanged(owner, bucket string) {
+	accessCache.Delete("acl/" + owner + "/" + bucket)
+	accessCache.Delete("policy/" + owner + "/" + bucket)
+	accessCache.Delete("web/" + owner + "/" + bucket)
+	if err := cache.PutBucketOwner(bucket, owner); err == cache.ErrBucketOwned {
+		logrus.Warnf("[S3]Bucket %s of %s is not shared:%s\n", bucket, owner, err)
+	} else if err != nil {
+		logrus.Errorf("[S3]Save bucket owner %s ERR:%s\n", bucket, err)
+	}
+}
+
+func (g *Server) routeACL(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getACL(bucket, object, versionID, w, r)
+	case "PUT":
+		return g.putACL(bucket, object, versionID, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getACL(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	acl := ACLPrivate
+	if g.acl != nil {
+		var err error
+		if object == "" {
+			acl, err = g.acl.BucketACL(accesskey, bucket)
+		} else {
+			acl, err = g.acl.ObjectACL(accesskey, bucket, object, versionID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return g.xmlEncoder(w).Encode(newAccessControlPolicy(accesskey, acl))
+}
+
+func (g *Server) putACL(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	var acl CannedACL
+	if h := r.Header.Get("X-Amz-Acl"); h != "" {
+		var err error
+		if acl, err = ParseCannedACL(h); err != nil {
+			return err
+		}
+	} else {
+		var in AccessControlPolicy
+		if err := g.xmlDecodeBody(r.Body, &in); err != nil {
+			return err
+		}
+		var err error
+		if acl, err = in.cannedACL(); err != nil {
+			return err
+		}
+	}
+	if g.acl == nil {
+		if acl != ACLPrivate {
+			return ErrNotImplemented
+		}
+		return nil
+	}
+	logrus.Infof("[S3]PUT ACL:/%s/%s,%s\n", bucket, object, acl)
+	return g.setACL(accesskey, bucket, object, versionID, acl)
+}
+
+func (g *Server) setACL(accesskey, bucket, object string, versionID VersionID, acl CannedACL) error {
+	var err error
+	if object == "" {
+		err = g.acl.SetBucketACL(accesskey, bucket, acl)
+	} else {
+		err = g.acl.SetObjectACL(accesskey, bucket, object, versionID, acl)
+	}
+	if err != nil {
+		return err
+	}
+	if object != "" {
+		accessCache.Delete(objectACLKey(accesskey, bucket, object, versionID))
+		accessCache.Delete(objectACLKey(accesskey, bucket, object, ""))
+	}
+	g.accessChanged(accesskey, bucket)
+	return nil
+}
@@ -2,6 +2,7 @@ package s3
 
 import (
 	"io"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
@@ -18,6 +19,10 @@ type Object struct {
 	Hash     []byte
 	Range    *ObjectRange
 
+	// LastModified is the version timestamp; when zero the Last-Modified
+	// metadata header is used for conditional requests.
+	LastModified time.Time
+
 	VersionID      VersionID
 	IsDeleteMarker bool
 }
@@ -75,6 +80,8 @@ func (p ListBucketPage) IsEmpty() bool {
 
 type PutObjectResult struct {
 	VersionID VersionID
+	// ETag is set if it is not the MD5 of the body, as for SSE-C objects.
+	ETag []byte
 }
 
 type Backend interface {
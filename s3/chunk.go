@@ -1,64 +1,91 @@
 package s3
 
 import (
-	"fmt"
+	"bufio"
+	"bytes"
 	"io"
-	"io/ioutil"
+	"strconv"
+	"strings"
 )
 
+const maxChunkSize = 16 << 20
+
 type chunkedReader struct {
-	inner         io.Reader
-	chunkRemain   int
-	notFirstChunk bool
+	inner  *bufio.Reader
+	closer io.Closer
+	signer *chunkSigner
+	buf    []byte
+	pos    int
+	done   bool
 }
 
-func newChunkedReader(inner io.Reader) *chunkedReader {
+func newChunkedReader(inner io.ReadCloser, signer *chunkSigner) *chunkedReader {
 	return &chunkedReader{
-		inner:         inner,
-		chunkRemain:   0,
-		notFirstChunk: false,
+		inner:  bufio.NewReader(inner),
+		closer: inner,
+		signer: signer,
 	}
 }
 
 func (r *chunkedReader) Read(p []byte) (n int, err error) {
-	sizeToRead := len(p)
-	for sizeToRead > 0 {
-		if r.chunkRemain > sizeToRead {
-			r.chunkRemain -= sizeToRead
-			innerN, err := r.inner.Read(p[n : n+sizeToRead])
-			sizeToRead -= innerN
-			n += innerN
-			if err != nil {
-				return n, err
-			}
-		} else if r.chunkRemain > 0 {
-			innerN, err := r.inner.Read(p[n : n+r.chunkRemain])
-			r.chunkRemain -= innerN
-			n += innerN
-			sizeToRead -= innerN
-			if err != nil {
-				return n, err
-			}
-		} else {
-			if !r.notFirstChunk {
-				r.notFirstChunk = true
-			} else {
-				_, err = io.CopyN(ioutil.Discard, r.inner, 2)
-				if err != nil {
-					return n, err
-				}
-			}
-			chunkSize := 0
-			_, err = fmt.Fscanf(r.inner, "%x;", &chunkSize)
-			if err != nil {
-				return n, err
-			}
-			r.chunkRemain = chunkSize
-			_, err = io.CopyN(ioutil.Discard, r.inner, 16+64+2)
-			if err != nil {
-				return n, err
-			}
+	for r.pos >= len(r.buf) {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.readChunk(); err != nil {
+			return 0, err
 		}
 	}
+	n = copy(p, r.buf[r.pos:])
+	r.pos += n
 	return n, nil
 }
+
+func (r *chunkedReader) Close() error {
+	return r.closer.Close()
+}
+
+func (r *chunkedReader) readChunk() error {
+	line, err := r.inner.ReadString('\n')
+	if err != nil {
+		return ErrIncompleteBody
+	}
+	line = strings.TrimRight(line, "\r\n")
+	var sig string
+	if idx := strings.IndexByte(line, ';'); idx >= 0 {
+		ext := line[idx+1:]
+		line = line[:idx]
+		if strings.HasPrefix(ext, "chunk-signature=") {
+			sig = strings.TrimPrefix(ext, "chunk-signature=")
+		}
+	}
+	size, err := strconv.ParseInt(strings.TrimSpace(line), 16, 64)
+	if err != nil || size < 0 || size > maxChunkSize {
+		return ErrIncompleteBody
+	}
+	if cap(r.buf) < int(size) {
+		r.buf = make([]byte, size)
+	}
+	r.buf = r.buf[:size]
+	r.pos = 0
+	if _, err := io.ReadFull(r.inner, r.buf); err != nil {
+		return ErrIncompleteBody
+	}
+	if r.signer != nil {
+		if sig == "" {
+			return ErrSignatureDoesNotMatch
+		}
+		if err := r.signer.verify(sig, r.buf); err != nil {
+			return err
+		}
+	}
+	if size == 0 {
+		r.done = true
+		return nil
+	}
+	crlf := make([]byte, 2)
+	if _, err := io.ReadFull(r.inner, crlf); err != nil || !bytes.Equal(crlf, []byte("\r\n")) {
+		return ErrIncompleteBody
+	}
+	return nil
+}
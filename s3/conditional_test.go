@@ -0,0 +1,117 @@
+package s3
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestEtagMatches(t *testing.T) {
+	etag := `"0123abcd"`
+	tests := []struct {
+		header string
+		match  bool
+	}{
+		{`"0123abcd"`, true},
+		{`0123abcd`, true},
+		{`W/"0123abcd"`, true},
+		{`*`, true},
+		{`"ffff", "0123abcd"`, true},
+		{`"ffff",W/"0123abcd"`, true},
+		{`"ffff"`, false},
+		{`"0123abc"`, false},
+		{``, false},
+	}
+	for _, tt := range tests {
+		if got := etagMatches(tt.header, etag); got != tt.match {
+			t.Errorf("etagMatches(%q) = %v", tt.header, got)
+		}
+	}
+}
+
+func TestCheckConditions(t *testing.T) {
+	etag := `"0123abcd"`
+	modified := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	before := modified.Add(-time.Hour).Format(http.TimeFormat)
+	at := modified.Format(http.TimeFormat)
+	after := modified.Add(time.Hour).Format(http.TimeFormat)
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		modified time.Time
+		err      error
+	}{
+		{"none", nil, modified, nil},
+		{"if-match", map[string]string{"If-Match": etag}, modified, nil},
+		{"if-match fails", map[string]string{"If-Match": `"ffff"`}, modified, ErrPreconditionFailed},
+		{"if-unmodified-since", map[string]string{"If-Unmodified-Since": at}, modified, nil},
+		{"if-unmodified-since fails", map[string]string{"If-Unmodified-Since": before}, modified, ErrPreconditionFailed},
+		{"if-match over if-unmodified-since", map[string]string{"If-Match": etag, "If-Unmodified-Since": before}, modified, nil},
+		{"if-unmodified-since unknown time", map[string]string{"If-Unmodified-Since": before}, time.Time{}, nil},
+		{"if-unmodified-since invalid", map[string]string{"If-Unmodified-Since": "yesterday"}, modified, nil},
+		{"if-none-match", map[string]string{"If-None-Match": `"ffff"`}, modified, nil},
+		{"if-none-match fails", map[string]string{"If-None-Match": etag}, modified, ErrNotModified},
+		{"if-none-match star", map[string]string{"If-None-Match": "*"}, modified, ErrNotModified},
+		{"if-modified-since", map[string]string{"If-Modified-Since": before}, modified, nil},
+		{"if-modified-since fails", map[string]string{"If-Modified-Since": at}, modified, ErrNotModified},
+		{"if-modified-since later", map[string]string{"If-Modified-Since": after}, modified, ErrNotModified},
+		{"if-none-match over if-modified-since", map[string]string{"If-None-Match": `"ffff"`, "If-Modified-Since": after}, modified, nil},
+		{"if-match then if-none-match", map[string]string{"If-Match": etag, "If-None-Match": etag}, modified, ErrNotModified},
+		{"failed if-match first", map[string]string{"If-Match": `"ffff"`, "If-None-Match": etag}, modified, ErrPreconditionFailed},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("GET", "/bucket/object", nil)
+		for k, v := range tt.headers {
+			r.Header.Set(k, v)
+		}
+		if err := checkConditions(r, etag, tt.modified); err != tt.err {
+			t.Errorf("%s: got %v, expected %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+type testHeadBackend struct {
+	Backend
+	objects map[string]*Object
+}
+
+func (b *testHeadBackend) HeadObject(accesskey string, bucketName, objectName string) (*Object, error) {
+	if obj, ok := b.objects[objectName]; ok {
+		return obj, nil
+	}
+	return nil, KeyNotFound(objectName)
+}
+
+func TestCheckWriteConditions(t *testing.T) {
+	g := &Server{storage: &testHeadBackend{objects: map[string]*Object{
+		"exists":  {Name: "exists", Hash: []byte{0x01, 0x23, 0xab, 0xcd}},
+		"deleted": {Name: "deleted", IsDeleteMarker: true},
+	}}}
+	tests := []struct {
+		name    string
+		object  string
+		headers map[string]string
+		code    ErrorCode
+	}{
+		{"unconditional", "exists", nil, ""},
+		{"create", "missing", map[string]string{"If-None-Match": "*"}, ""},
+		{"create over delete marker", "deleted", map[string]string{"If-None-Match": "*"}, ""},
+		{"create exists", "exists", map[string]string{"If-None-Match": "*"}, ErrPreconditionFailed},
+		{"if-none-match etag", "exists", map[string]string{"If-None-Match": `"0123abcd"`}, ErrInvalidArgument},
+		{"swap", "exists", map[string]string{"If-Match": `"0123abcd"`}, ""},
+		{"swap changed", "exists", map[string]string{"If-Match": `"ffff"`}, ErrPreconditionFailed},
+		{"swap missing", "missing", map[string]string{"If-Match": `"0123abcd"`}, ErrNoSuchKey},
+		{"swap deleted", "deleted", map[string]string{"If-Match": "*"}, ErrNoSuchKey},
+	}
+	for _, tt := range tests {
+		r := httptest.NewRequest("PUT", "/bucket/"+tt.object, nil)
+		for k, v := range tt.headers {
+			r.Header.Set(k, v)
+		}
+		err := g.checkWriteConditions("owner", "bucket", tt.object, r)
+		if !HasErrorCode(err, tt.code) {
+			t.Errorf("%s: got %v, expected %q", tt.name, err, tt.code)
+		}
+	}
+}
@@ -33,8 +33,12 @@ const (
 	ErrInvalidDigest ErrorCode = "InvalidDigest"
 
 	ErrInvalidRange         ErrorCode = "InvalidRange"
+	ErrInvalidRequest       ErrorCode = "InvalidRequest"
+	ErrInvalidTag           ErrorCode = "InvalidTag"
 	ErrInvalidToken         ErrorCode = "InvalidToken"
 	ErrKeyTooLong           ErrorCode = "KeyTooLongError"
+	ErrMalformedACLError    ErrorCode = "MalformedACLError"
+	ErrMalformedPolicy      ErrorCode = "MalformedPolicy"
 	ErrMalformedPOSTRequest ErrorCode = "MalformedPOSTRequest"
 
 	ErrInvalidPart ErrorCode = "InvalidPart"
@@ -57,10 +61,23 @@ const (
 
 	ErrNoSuchVersion ErrorCode = "NoSuchVersion"
 
+	ErrNoSuchLifecycleConfiguration ErrorCode = "NoSuchLifecycleConfiguration"
+
+	ErrNoSuchBucketPolicy ErrorCode = "NoSuchBucketPolicy"
+
+	ErrNoSuchWebsiteConfiguration ErrorCode = "NoSuchWebsiteConfiguration"
+
+	ErrNoSuchObjectLockConfiguration ErrorCode = "ObjectLockConfigurationNotFoundError"
+
+	ErrInvalidBucketState ErrorCode = "InvalidBucketState"
+
 	ErrNotModified ErrorCode = "NotModified"
 
+	ErrPreconditionFailed ErrorCode = "PreconditionFailed"
+
 	ErrRequestTimeTooSkewed ErrorCode = "RequestTimeTooSkewed"
 	ErrTooManyBuckets       ErrorCode = "TooManyBuckets"
+	ErrQuotaExceeded        ErrorCode = "QuotaExceeded"
 	ErrNotImplemented       ErrorCode = "NotImplemented"
 
 	ErrInternal ErrorCode = "InternalError"
@@ -107,10 +124,14 @@ func EnsureErrorResponse(err error, requestID string) Error {
 		return err
 
 	case ErrorCode:
+		msg := err.Message()
+		if msg == "" {
+			msg = string(err)
+		}
 		return &ErrorResponse{
 			Code:      err,
 			RequestID: requestID,
-			Message:   string(err),
+			Message:   msg,
 		}
 
 	default:
@@ -183,10 +204,36 @@ func (e ErrorCode) Message() string {
 		return `Bucket name must match the regex "^[a-zA-Z0-9.\-_]{1,255}$"`
 	case ErrNoSuchBucket:
 		return "The specified bucket does not exist"
+	case ErrNoSuchLifecycleConfiguration:
+		return "The lifecycle configuration does not exist"
+	case ErrNoSuchBucketPolicy:
+		return "The bucket policy does not exist"
+	case ErrNoSuchWebsiteConfiguration:
+		return "The specified bucket does not have a website configuration"
+	case ErrPreconditionFailed:
+		return "At least one of the pre-conditions you specified did not hold"
 	case ErrRequestTimeTooSkewed:
 		return "The difference between the request time and the current time is too large"
 	case ErrMalformedXML:
 		return "The XML you provided was not well-formed or did not validate against our published schema"
+	case ErrAccessDenied:
+		return "Access Denied"
+	case ErrSignatureDoesNotMatch:
+		return "The request signature we calculated does not match the signature you provided"
+	case ErrInvalidAccessKeyID:
+		return "The access key Id you provided does not exist in our records"
+	case ErrContentSHA256Mismatch:
+		return "The provided 'x-amz-content-sha256' header does not match what was computed"
+	case ErrExpiredPresignRequest:
+		return "Request has expired"
+	case ErrSignatureVersionNotSupported:
+		return "The authorization mechanism you have provided is not supported. Please use AWS4-HMAC-SHA256"
+	case ErrQuotaExceeded:
+		return "The bucket quota has been exceeded"
+	case ErrNoSuchObjectLockConfiguration:
+		return "Object Lock configuration does not exist for this bucket"
+	case ErrInvalidBucketState:
+		return "The request is not valid with the current state of the bucket"
 	default:
 		return ""
 	}
@@ -195,6 +242,7 @@ func (e ErrorCode) Message() string {
 func (e ErrorCode) Status() int {
 	switch e {
 	case ErrBucketAlreadyExists,
+		ErrInvalidBucketState,
 		ErrBucketNotEmpty:
 		return http.StatusConflict
 
@@ -208,9 +256,13 @@ func (e ErrorCode) Status() int {
 		ErrInvalidDigest,
 		ErrInvalidPart,
 		ErrInvalidPartOrder,
+		ErrInvalidRequest,
 		ErrInvalidToken,
+		ErrInvalidTag,
 		ErrInvalidURI,
 		ErrKeyTooLong,
+		ErrMalformedACLError,
+		ErrMalformedPolicy,
 		ErrMetadataTooLarge,
 		ErrMethodNotAllowed,
 		ErrMalformedPOSTRequest,
@@ -243,6 +295,7 @@ func (e ErrorCode) Status() int {
 		ErrInvalidAccessKeyID,
 		ErrExpiredPresignRequest,
 		ErrSignatureVersionNotSupported,
+		ErrQuotaExceeded,
 		ErrAccessDenied:
 		return http.StatusForbidden
 
@@ -252,7 +305,11 @@ func (e ErrorCode) Status() int {
 	case ErrNoSuchBucket,
 		ErrNoSuchKey,
 		ErrNoSuchUpload,
-		ErrNoSuchVersion:
+		ErrNoSuchVersion,
+		ErrNoSuchLifecycleConfiguration,
+		ErrNoSuchBucketPolicy,
+		ErrNoSuchObjectLockConfiguration,
+		ErrNoSuchWebsiteConfiguration:
 		return http.StatusNotFound
 
 	case ErrNotImplemented:
@@ -261,6 +318,9 @@ func (e ErrorCode) Status() int {
 	case ErrNotModified:
 		return http.StatusNotModified
 
+	case ErrPreconditionFailed:
+		return http.StatusPreconditionFailed
+
 	case ErrMissingContentLength:
 		return http.StatusLengthRequired
 
@@ -3,6 +3,7 @@ package s3
 import (
 	"bytes"
 	"crypto/md5"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 	"hash"
@@ -61,3 +62,32 @@ func (h *hashingReader) Read(p []byte) (n int, err error) {
 	}
 	return n, nil
 }
+
+type sha256Reader struct {
+	inner    io.ReadCloser
+	expected []byte
+	hash     hash.Hash
+}
+
+func newSHA256Reader(inner io.ReadCloser, expected []byte) *sha256Reader {
+	return &sha256Reader{
+		inner:    inner,
+		expected: expected,
+		hash:     sha256.New(),
+	}
+}
+
+func (h *sha256Reader) Read(p []byte) (n int, err error) {
+	n, err = h.inner.Read(p)
+	if n != 0 {
+		h.hash.Write(p[:n])
+	}
+	if err == io.EOF && !bytes.Equal(h.hash.Sum(nil), h.expected) {
+		return n, ErrContentSHA256Mismatch
+	}
+	return n, err
+}
+
+func (h *sha256Reader) Close() error {
+	return h.inner.Close()
+}
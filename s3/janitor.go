@@ -0,0 +1,63 @@
+package s3
+
+import (
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/api/cache"
+	"github.com/yottachain/YTCoreService/pkt"
+)
+
+func (g *Server) uploadJanitor() {
+	for {
+		time.Sleep(MultipartJanitorInterval)
+		g.abortExpiredUploads()
+	}
+}
+
+func (g *Server) abortExpiredUploads() {
+	defer func() {
+		if r := recover(); r != nil {
+			logrus.Errorf("[MultipartUpload]Janitor ERR:%s\n", r)
+		}
+	}()
+	confs := g.lifecycleConfigs()
+	expired := g.uploader.Expired(g.timeSource.Now(), func(mpu *multipartUpload) time.Duration {
+		return g.uploadExpiry(confs, mpu)
+	})
+	var size int64
+	for _, mpu := range expired {
+		n := mpu.RemoveFiles()
+		logrus.Infof("[MultipartUpload]Abort expired upload:/%s/%s/%s,initiated:%s,reclaimed %d bytes\n",
+			mpu.Bucket, mpu.Object, mpu.ID, mpu.Initiated.Format(time.RFC3339), n)
+		size = size + n
+	}
+	count, orphan := cache.ClearOrphanDirs(MultipartUploadExpiry, g.uploader.ActiveDirs())
+	if len(expired) > 0 || count > 0 {
+		logrus.Infof("[MultipartUpload]Janitor aborted %d uploads,removed %d orphan dirs,reclaimed %d bytes\n",
+			len(expired), count, size+orphan)
+	}
+}
+
+func (g *Server) lifecycleConfigs() map[uploadOwner]*pkt.LifecycleConfiguration {
+	confs := make(map[uploadOwner]*pkt.LifecycleConfiguration)
+	if g.lifecycle == nil {
+		return confs
+	}
+	for o := range g.uploader.BucketOwners() {
+		conf, err := g.lifecycle.LifecycleConfiguration(o.AccessKey, o.Bucket)
+		if err == nil && conf != nil {
+			confs[o] = conf.ToPkt()
+		}
+	}
+	return confs
+}
+
+func (g *Server) uploadExpiry(confs map[uploadOwner]*pkt.LifecycleConfiguration, mpu *multipartUpload) time.Duration {
+	if conf, ok := confs[uploadOwner{AccessKey: mpu.AccessKey, Bucket: mpu.Bucket}]; ok {
+		if days := conf.AbortDays(mpu.Object); days > 0 {
+			return time.Duration(days) * 24 * time.Hour
+		}
+	}
+	return MultipartUploadExpiry
+}
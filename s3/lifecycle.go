@@ -0,0 +1,232 @@
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/pkt"
+)
+
+const MaxLifecycleRules = 1000
+
+type LifecycleConfiguration struct {
+	XMLName xml.Name         `xml:"LifecycleConfiguration"`
+	Rules   []*LifecycleRule `xml:"Rule"`
+}
+
+type LifecycleRule struct {
+	ID                             string                          `xml:"ID,omitempty"`
+	Status                         string                          `xml:"Status"`
+	Prefix                         *string                         `xml:"Prefix,omitempty"`
+	Filter                         *LifecycleFilter                `xml:"Filter,omitempty"`
+	Expiration                     *LifecycleExpiration            `xml:"Expiration,omitempty"`
+	NoncurrentVersionExpiration    *NoncurrentVersionExpiration    `xml:"NoncurrentVersionExpiration,omitempty"`
+	AbortIncompleteMultipartUpload *AbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload,omitempty"`
+}
+
+type LifecycleFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type LifecycleExpiration struct {
+	Days int    `xml:"Days,omitempty"`
+	Date string `xml:"Date,omitempty"`
+}
+
+type NoncurrentVersionExpiration struct {
+	NoncurrentDays          int `xml:"NoncurrentDays,omitempty"`
+	NewerNoncurrentVersions int `xml:"NewerNoncurrentVersions,omitempty"`
+}
+
+type AbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+type LifecycleBackend interface {
+	LifecycleConfiguration(accesskey string, bucket string) (*LifecycleConfiguration, error)
+
+	SetLifecycleConfiguration(accesskey string, bucket string, config *LifecycleConfiguration) error
+
+	DeleteLifecycleConfiguration(accesskey string, bucket string) error
+}
+
+func (c *LifecycleConfiguration) Validate() error {
+	if len(c.Rules) == 0 {
+		return errors.New("at least one rule is required")
+	}
+	if len(c.Rules) > MaxLifecycleRules {
+		return fmt.Errorf("at most %d rules are allowed", MaxLifecycleRules)
+	}
+	ids := make(map[string]bool)
+	for _, rule := range c.Rules {
+		if len(rule.ID) > 255 {
+			return errors.New("rule ID must be at most 255 characters")
+		}
+		if rule.ID != "" {
+			if ids[rule.ID] {
+				return fmt.Errorf("duplicate rule ID %q", rule.ID)
+			}
+			ids[rule.ID] = true
+		}
+		if err := rule.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *LifecycleRule) validate() error {
+	if r.Status != "Enabled" && r.Status != "Disabled" {
+		return fmt.Errorf("unexpected value %q for Status, expected 'Enabled' or 'Disabled'", r.Status)
+	}
+	if r.Prefix != nil && r.Filter != nil {
+		return errors.New("rule cannot have both Prefix and Filter")
+	}
+	if r.Expiration == nil && r.NoncurrentVersionExpiration == nil && r.AbortIncompleteMultipartUpload == nil {
+		return errors.New("rule must specify at least one action")
+	}
+	if e := r.Expiration; e != nil {
+		if (e.Days > 0) == (e.Date != "") {
+			return errors.New("Expiration must specify exactly one of Days or Date")
+		}
+		if e.Days < 0 {
+			return errors.New("Expiration Days must be a positive integer")
+		}
+		if e.Date != "" {
+			if _, err := time.Parse(time.RFC3339, e.Date); err != nil {
+				return fmt.Errorf("Expiration Date %q is not in ISO 8601 format", e.Date)
+			}
+		}
+	}
+	if n := r.NoncurrentVersionExpiration; n != nil {
+		if n.NoncurrentDays < 0 || n.NewerNoncurrentVersions < 0 {
+			return errors.New("NoncurrentVersionExpiration values must be positive integers")
+		}
+		if n.NoncurrentDays == 0 && n.NewerNoncurrentVersions == 0 {
+			return errors.New("NoncurrentVersionExpiration must specify NoncurrentDays or NewerNoncurrentVersions")
+		}
+	}
+	if a := r.AbortIncompleteMultipartUpload; a != nil && a.DaysAfterInitiation <= 0 {
+		return errors.New("DaysAfterInitiation must be a positive integer")
+	}
+	return nil
+}
+
+// ToPkt converts the configuration to the form kept in the bucket meta,
+// which also holds the rule matching shared with the SN.
+func (c *LifecycleConfiguration) ToPkt() *pkt.LifecycleConfiguration {
+	conf := &pkt.LifecycleConfiguration{}
+	for _, r := range c.Rules {
+		rule := &pkt.LifecycleRule{ID: r.ID, Status: r.Status, Prefix: r.Prefix}
+		if r.Filter != nil {
+			rule.Filter = &pkt.LifecycleFilter{Prefix: r.Filter.Prefix}
+		}
+		if e := r.Expiration; e != nil {
+			rule.Expiration = &pkt.LifecycleExpiration{Days: e.Days, Date: e.Date}
+		}
+		if n := r.NoncurrentVersionExpiration; n != nil {
+			rule.NoncurrentVersionExpiration = &pkt.NoncurrentVersionExpiration{NoncurrentDays: n.NoncurrentDays, NewerNoncurrentVersions: n.NewerNoncurrentVersions}
+		}
+		if a := r.AbortIncompleteMultipartUpload; a != nil {
+			rule.AbortIncompleteMultipartUpload = &pkt.AbortIncompleteMultipartUpload{DaysAfterInitiation: a.DaysAfterInitiation}
+		}
+		conf.Rules = append(conf.Rules, rule)
+	}
+	return conf
+}
+
+// LifecycleFromPkt is the inverse of ToPkt.
+func LifecycleFromPkt(conf *pkt.LifecycleConfiguration) *LifecycleConfiguration {
+	config := &LifecycleConfiguration{}
+	for _, r := range conf.Rules {
+		rule := &LifecycleRule{ID: r.ID, Status: r.Status, Prefix: r.Prefix}
+		if r.Filter != nil {
+			rule.Filter = &LifecycleFilter{Prefix: r.Filter.Prefix}
+		}
+		if e := r.Expiration; e != nil {
+			rule.Expiration = &LifecycleExpiration{Days: e.Days, Date: e.Date}
+		}
+		if n := r.NoncurrentVersionExpiration; n != nil {
+			rule.NoncurrentVersionExpiration = &NoncurrentVersionExpiration{NoncurrentDays: n.NoncurrentDays, NewerNoncurrentVersions: n.NewerNoncurrentVersions}
+		}
+		if a := r.AbortIncompleteMultipartUpload; a != nil {
+			rule.AbortIncompleteMultipartUpload = &AbortIncompleteMultipartUpload{DaysAfterInitiation: a.DaysAfterInitiation}
+		}
+		config.Rules = append(config.Rules, rule)
+	}
+	return config
+}
+
+func (g *Server) routeLifecycle(bucket string, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getBucketLifecycle(bucket, w, r)
+	case "PUT":
+		return g.putBucketLifecycle(bucket, w, r)
+	case "DELETE":
+		return g.deleteBucketLifecycle(bucket, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getBucketLifecycle(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.lifecycle == nil {
+		return ResourceError(ErrNoSuchLifecycleConfiguration, bucket)
+	}
+	config, err := g.lifecycle.LifecycleConfiguration(accesskey, bucket)
+	if err != nil {
+		return err
+	}
+	return g.xmlEncoder(w).Encode(config)
+}
+
+func (g *Server) putBucketLifecycle(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.lifecycle == nil {
+		return ErrNotImplemented
+	}
+	var in LifecycleConfiguration
+	if err := g.xmlDecodeBody(r.Body, &in); err != nil {
+		return err
+	}
+	if err := in.Validate(); err != nil {
+		return ErrorMessage(ErrMalformedXML, err.Error())
+	}
+	logrus.Infof("[S3]PUT LIFECYCLE:%s,rules:%d\n", bucket, len(in.Rules))
+	return g.lifecycle.SetLifecycleConfiguration(accesskey, bucket, &in)
+}
+
+func (g *Server) deleteBucketLifecycle(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.lifecycle != nil {
+		if err := g.lifecycle.DeleteLifecycleConfiguration(accesskey, bucket); err != nil {
+			return err
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
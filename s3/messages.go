@@ -349,6 +349,12 @@ type CopyObjectResult struct {
 	LastModified ContentTime `xml:"LastModified,omitempty"`
 }
 
+type CopyPartResult struct {
+	XMLName      xml.Name    `xml:"CopyPartResult"`
+	ETag         string      `xml:"ETag,omitempty"`
+	LastModified ContentTime `xml:"LastModified,omitempty"`
+}
+
 type MFADeleteStatus string
 
 func (v MFADeleteStatus) Enabled() bool { return v == MFADeleteEnabled }
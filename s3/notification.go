@@ -0,0 +1,145 @@
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+var notificationEvents = map[string]bool{
+	"s3:ObjectCreated:*":      true,
+	"s3:ObjectCreated:Put":    true,
+	"s3:ObjectCreated:Copy":   true,
+	"s3:ObjectRemoved:*":      true,
+	"s3:ObjectRemoved:Delete": true,
+}
+
+const MaxWebhookConfigurations = 100
+
+// NotificationConfiguration posts S3 event records to HTTP webhooks when
+// objects of the bucket are created or deleted.
+type NotificationConfiguration struct {
+	XMLName  xml.Name                `xml:"NotificationConfiguration"`
+	Webhooks []*WebhookConfiguration `xml:"WebhookConfiguration"`
+}
+
+type WebhookConfiguration struct {
+	ID       string              `xml:"Id,omitempty"`
+	Endpoint string              `xml:"Endpoint"`
+	Events   []string            `xml:"Event"`
+	Filter   *NotificationFilter `xml:"Filter,omitempty"`
+}
+
+type NotificationFilter struct {
+	Rules []*FilterRule `xml:"S3Key>FilterRule"`
+}
+
+type FilterRule struct {
+	Name  string `xml:"Name"`
+	Value string `xml:"Value"`
+}
+
+// NotificationBackend stores the webhooks the SN posts object created and
+// removed events to.
+type NotificationBackend interface {
+	// BucketNotification returns an empty configuration if none is set.
+	BucketNotification(accesskey string, bucket string) (*NotificationConfiguration, error)
+
+	// SetBucketNotification replaces the configuration; one without
+	// webhooks turns notifications off.
+	SetBucketNotification(accesskey string, bucket string, conf *NotificationConfiguration) error
+}
+
+func (c *NotificationConfiguration) Validate() error {
+	if len(c.Webhooks) > MaxWebhookConfigurations {
+		return fmt.Errorf("at most %d webhook configurations are allowed", MaxWebhookConfigurations)
+	}
+	ids := make(map[string]bool)
+	for _, w := range c.Webhooks {
+		if w.ID != "" {
+			if ids[w.ID] {
+				return errors.New("duplicate configuration id " + w.ID)
+			}
+			ids[w.ID] = true
+		}
+		u, err := url.Parse(w.Endpoint)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("invalid webhook endpoint " + w.Endpoint)
+		}
+		if len(w.Events) == 0 {
+			return errors.New("webhook configuration requires at least one event")
+		}
+		for _, e := range w.Events {
+			if !notificationEvents[e] {
+				return errors.New("unsupported event " + e)
+			}
+		}
+		if w.Filter != nil {
+			names := make(map[string]bool)
+			for _, r := range w.Filter.Rules {
+				name := strings.ToLower(r.Name)
+				if (name != "prefix" && name != "suffix") || names[name] {
+					return errors.New("filter rule name must be prefix or suffix, each at most once")
+				}
+				names[name] = true
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Server) routeNotification(bucket string, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getBucketNotification(bucket, w, r)
+	case "PUT":
+		return g.putBucketNotification(bucket, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getBucketNotification(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.notification == nil {
+		return g.xmlEncoder(w).Encode(&NotificationConfiguration{})
+	}
+	conf, err := g.notification.BucketNotification(accesskey, bucket)
+	if err != nil {
+		return err
+	}
+	return g.xmlEncoder(w).Encode(conf)
+}
+
+func (g *Server) putBucketNotification(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.notification == nil {
+		return ErrNotImplemented
+	}
+	var in NotificationConfiguration
+	if err := g.xmlDecodeBody(r.Body, &in); err != nil {
+		return err
+	}
+	if err := in.Validate(); err != nil {
+		return ErrorMessage(ErrInvalidArgument, err.Error())
+	}
+	logrus.Infof("[S3]PUT NOTIFICATION:%s,webhooks:%d\n", bucket, len(in.Webhooks))
+	return g.notification.SetBucketNotification(accesskey, bucket, &in)
+}
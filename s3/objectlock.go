@@ -0,0 +1,308 @@
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	LockModeGovernance = "GOVERNANCE"
+	LockModeCompliance = "COMPLIANCE"
+)
+
+const (
+	objectLockModeHeader        = "X-Amz-Object-Lock-Mode"
+	objectLockRetainUntilHeader = "X-Amz-Object-Lock-Retain-Until-Date"
+	objectLockLegalHoldHeader   = "X-Amz-Object-Lock-Legal-Hold"
+	bypassGovernanceHeader      = "X-Amz-Bypass-Governance-Retention"
+)
+
+type ObjectLockConfiguration struct {
+	XMLName           xml.Name        `xml:"ObjectLockConfiguration"`
+	ObjectLockEnabled string          `xml:"ObjectLockEnabled,omitempty"`
+	Rule              *ObjectLockRule `xml:"Rule,omitempty"`
+}
+
+type ObjectLockRule struct {
+	DefaultRetention *DefaultRetention `xml:"DefaultRetention"`
+}
+
+type DefaultRetention struct {
+	Mode  string `xml:"Mode"`
+	Days  int    `xml:"Days,omitempty"`
+	Years int    `xml:"Years,omitempty"`
+}
+
+type ObjectRetention struct {
+	XMLName         xml.Name `xml:"Retention"`
+	Mode            string   `xml:"Mode,omitempty"`
+	RetainUntilDate string   `xml:"RetainUntilDate,omitempty"`
+}
+
+// Until returns the retain-until date as unix seconds, 0 if unset.
+func (r *ObjectRetention) Until() int64 {
+	t, err := time.Parse(time.RFC3339, r.RetainUntilDate)
+	if err != nil {
+		return 0
+	}
+	return t.Unix()
+}
+
+type ObjectLegalHold struct {
+	XMLName xml.Name `xml:"LegalHold"`
+	Status  string   `xml:"Status"`
+}
+
+func (c *ObjectLockConfiguration) Validate() error {
+	if c.ObjectLockEnabled != "Enabled" {
+		return errors.New("ObjectLockEnabled must be Enabled")
+	}
+	if c.Rule == nil {
+		return nil
+	}
+	r := c.Rule.DefaultRetention
+	if r == nil {
+		return errors.New("Rule requires DefaultRetention")
+	}
+	if !validLockMode(r.Mode) {
+		return errors.New("invalid retention mode")
+	}
+	if r.Days < 0 || r.Years < 0 || (r.Days > 0) == (r.Years > 0) {
+		return errors.New("DefaultRetention requires either Days or Years")
+	}
+	return nil
+}
+
+func validLockMode(mode string) bool {
+	return mode == LockModeGovernance || mode == LockModeCompliance
+}
+
+var ErrObjectLocked = ErrorMessage(ErrAccessDenied, "Access Denied because object protected by object lock")
+
+// ObjectLockBackend keeps object versions write-once: versions under
+// retention or legal hold are refused deletion by the SN. Object lock can
+// only be enabled, never disabled, and implies versioning.
+type ObjectLockBackend interface {
+	// ObjectLockConfiguration returns ErrNoSuchObjectLockConfiguration if
+	// object lock is not enabled on the bucket.
+	ObjectLockConfiguration(accesskey string, bucket string) (*ObjectLockConfiguration, error)
+
+	SetObjectLockConfiguration(accesskey string, bucket string, conf *ObjectLockConfiguration) error
+
+	ObjectRetention(accesskey string, bucket, object string, versionID VersionID) (*ObjectRetention, error)
+
+	PutObjectRetention(accesskey string, bucket, object string, versionID VersionID, retention *ObjectRetention, bypass bool) error
+
+	ObjectLegalHold(accesskey string, bucket, object string, versionID VersionID) (*ObjectLegalHold, error)
+
+	PutObjectLegalHold(accesskey string, bucket, object string, versionID VersionID, hold *ObjectLegalHold) error
+
+	// DeleteObjectBypass deletes the object, or just versionID if set, also
+	// removing versions under governance retention.
+	DeleteObjectBypass(accesskey string, bucket, object string, versionID VersionID) (ObjectDeleteResult, error)
+}
+
+func IsObjectLockHeader(key string) bool {
+	return key == objectLockModeHeader || key == objectLockRetainUntilHeader || key == objectLockLegalHoldHeader
+}
+
+// checkObjectLockHeaders validates the lock requested for a new object,
+// which is only accepted in buckets with object lock enabled.
+func (g *Server) checkObjectLockHeaders(accesskey, bucket string, meta map[string]string) error {
+	mode, until, hold := meta[objectLockModeHeader], meta[objectLockRetainUntilHeader], meta[objectLockLegalHoldHeader]
+	if mode == "" && until == "" && hold == "" {
+		return nil
+	}
+	var retainUntil time.Time
+	if mode != "" || until != "" {
+		if !validLockMode(strings.ToUpper(mode)) {
+			return ErrorMessage(ErrInvalidArgument, "invalid object lock mode")
+		}
+		t, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return ErrorMessage(ErrInvalidArgument, "invalid retain until date")
+		}
+		retainUntil = t
+	}
+	switch strings.ToUpper(hold) {
+	case "", "OFF", "ON":
+	default:
+		return ErrorMessage(ErrInvalidArgument, "invalid legal hold status")
+	}
+	if g.objectLock == nil {
+		return ErrNotImplemented
+	}
+	if _, err := g.objectLock.ObjectLockConfiguration(accesskey, bucket); err != nil {
+		if HasErrorCode(err, ErrNoSuchObjectLockConfiguration) {
+			return ErrorMessage(ErrInvalidRequest, "Bucket is missing Object Lock Configuration")
+		}
+		return err
+	}
+	if mode != "" && !retainUntil.After(g.timeSource.Now()) {
+		return ErrorMessage(ErrInvalidArgument, "The retain until date must be in the future")
+	}
+	return nil
+}
+
+// bypassGovernance reports whether the request asks to bypass governance
+// retention and is allowed to.
+func (g *Server) bypassGovernance(bucket, object string, r *http.Request) bool {
+	if !strings.EqualFold(r.Header.Get(bypassGovernanceHeader), "true") || g.objectLock == nil {
+		return false
+	}
+	_, err := g.authorize(r, bucket, object, ActionBypassGovernanceRetention)
+	return err == nil
+}
+
+func (g *Server) routeObjectLock(bucket string, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getObjectLockConfiguration(bucket, w, r)
+	case "PUT":
+		return g.putObjectLockConfiguration(bucket, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getObjectLockConfiguration(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.objectLock == nil {
+		return ResourceError(ErrNoSuchObjectLockConfiguration, bucket)
+	}
+	conf, err := g.objectLock.ObjectLockConfiguration(accesskey, bucket)
+	if err != nil {
+		return err
+	}
+	return g.xmlEncoder(w).Encode(conf)
+}
+
+func (g *Server) putObjectLockConfiguration(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.objectLock == nil {
+		return ErrNotImplemented
+	}
+	var in ObjectLockConfiguration
+	if err := g.xmlDecodeBody(r.Body, &in); err != nil {
+		return err
+	}
+	if err := in.Validate(); err != nil {
+		return ErrorMessage(ErrMalformedXML, err.Error())
+	}
+	logrus.Infof("[S3]PUT OBJECT LOCK:%s\n", bucket)
+	return g.objectLock.SetObjectLockConfiguration(accesskey, bucket, &in)
+}
+
+func (g *Server) routeRetention(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getObjectRetention(bucket, object, versionID, w, r)
+	case "PUT":
+		return g.putObjectRetention(bucket, object, versionID, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getObjectRetention(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, object, ActionGetObjectRetention)
+	if autherr != nil {
+		return autherr
+	}
+	if g.objectLock == nil {
+		return ErrNotImplemented
+	}
+	out, err := g.objectLock.ObjectRetention(accesskey, bucket, object, versionID)
+	if err != nil {
+		return err
+	}
+	return g.xmlEncoder(w).Encode(out)
+}
+
+func (g *Server) putObjectRetention(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, object, ActionPutObjectRetention)
+	if autherr != nil {
+		return autherr
+	}
+	if g.objectLock == nil {
+		return ErrNotImplemented
+	}
+	var in ObjectRetention
+	if err := g.xmlDecodeBody(r.Body, &in); err != nil {
+		return err
+	}
+	if in.Mode != "" || in.RetainUntilDate != "" {
+		if !validLockMode(in.Mode) {
+			return ErrorMessage(ErrMalformedXML, "invalid retention mode")
+		}
+		until, err := time.Parse(time.RFC3339, in.RetainUntilDate)
+		if err != nil {
+			return ErrorMessage(ErrMalformedXML, "invalid RetainUntilDate")
+		}
+		if !until.After(g.timeSource.Now()) {
+			return ErrorMessage(ErrInvalidArgument, "The retain until date must be in the future")
+		}
+	}
+	return g.objectLock.PutObjectRetention(accesskey, bucket, object, versionID, &in, g.bypassGovernance(bucket, object, r))
+}
+
+func (g *Server) routeLegalHold(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getObjectLegalHold(bucket, object, versionID, w, r)
+	case "PUT":
+		return g.putObjectLegalHold(bucket, object, versionID, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getObjectLegalHold(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, object, ActionGetObjectLegalHold)
+	if autherr != nil {
+		return autherr
+	}
+	if g.objectLock == nil {
+		return ErrNotImplemented
+	}
+	out, err := g.objectLock.ObjectLegalHold(accesskey, bucket, object, versionID)
+	if err != nil {
+		return err
+	}
+	return g.xmlEncoder(w).Encode(out)
+}
+
+func (g *Server) putObjectLegalHold(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, object, ActionPutObjectLegalHold)
+	if autherr != nil {
+		return autherr
+	}
+	if g.objectLock == nil {
+		return ErrNotImplemented
+	}
+	var in ObjectLegalHold
+	if err := g.xmlDecodeBody(r.Body, &in); err != nil {
+		return err
+	}
+	if in.Status != "ON" && in.Status != "OFF" {
+		return ErrorMessage(ErrMalformedXML, "legal hold status must be ON or OFF")
+	}
+	return g.objectLock.PutObjectLegalHold(accesskey, bucket, object, versionID, &in)
+}
@@ -1,5 +1,7 @@
 package s3
 
+import "time"
+
 var (
 	DefaultMetadataSizeLimit = 2000
 	KeySizeLimit             = 1024
@@ -18,4 +20,13 @@ var (
 	DefaultMaxBucketVersionKeys int64 = 1000
 
 	MaxUploadPartNumber int64 = 10000
+
+	// MaxObjectRanges caps the parts of a multi-range GET after merging;
+	// requests asking for more get the whole object.
+	MaxObjectRanges = 16
+)
+
+var (
+	MultipartUploadExpiry    = 72 * time.Hour
+	MultipartJanitorInterval = time.Hour
 )
@@ -0,0 +1,281 @@
+package s3
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	ActionAll                        = "s3:*"
+	ActionGetObject                  = "s3:GetObject"
+	ActionPutObject                  = "s3:PutObject"
+	ActionDeleteObject               = "s3:DeleteObject"
+	ActionGetObjectTagging           = "s3:GetObjectTagging"
+	ActionPutObjectTagging           = "s3:PutObjectTagging"
+	ActionDeleteObjectTagging        = "s3:DeleteObjectTagging"
+	ActionListBucket                 = "s3:ListBucket"
+	ActionListBucketVersions         = "s3:ListBucketVersions"
+	ActionListBucketMultipartUploads = "s3:ListBucketMultipartUploads"
+	ActionListMultipartUploadParts   = "s3:ListMultipartUploadParts"
+	ActionAbortMultipartUpload       = "s3:AbortMultipartUpload"
+	ActionGetBucketLocation          = "s3:GetBucketLocation"
+	ActionGetObjectRetention         = "s3:GetObjectRetention"
+	ActionPutObjectRetention         = "s3:PutObjectRetention"
+	ActionGetObjectLegalHold         = "s3:GetObjectLegalHold"
+	ActionPutObjectLegalHold         = "s3:PutObjectLegalHold"
+	ActionBypassGovernanceRetention  = "s3:BypassGovernanceRetention"
+
+	// actionOwner marks bucket configuration requests, which are never granted
+	// to anyone but the bucket owner.
+	actionOwner = ""
+
+	PolicyEffectAllow = "Allow"
+	PolicyEffectDeny  = "Deny"
+
+	MaxBucketPolicySize = 20 * 1024
+	resourceARNPrefix   = "arn:aws:s3:::"
+)
+
+type policyDecision int
+
+const (
+	policyNone policyDecision = iota
+	policyAllow
+	policyDeny
+)
+
+type BucketPolicy struct {
+	Version   string            `json:"Version,omitempty"`
+	ID        string            `json:"Id,omitempty"`
+	Statement []PolicyStatement `json:"Statement"`
+}
+
+type PolicyStatement struct {
+	Sid       string          `json:"Sid,omitempty"`
+	Effect    string          `json:"Effect"`
+	Principal PolicyPrincipal `json:"Principal"`
+	Action    stringOrSlice   `json:"Action"`
+	Resource  stringOrSlice   `json:"Resource"`
+}
+
+// PolicyPrincipal is either "*" or {"AWS": [...]} holding access keys.
+type PolicyPrincipal struct {
+	AWS stringOrSlice `json:"AWS,omitempty"`
+}
+
+func (p *PolicyPrincipal) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		if s != "*" {
+			return fmt.Errorf("invalid principal %q", s)
+		}
+		p.AWS = stringOrSlice{"*"}
+		return nil
+	}
+	type principal PolicyPrincipal
+	return json.Unmarshal(data, (*principal)(p))
+}
+
+type stringOrSlice []string
+
+func (s *stringOrSlice) UnmarshalJSON(data []byte) error {
+	var one string
+	if err := json.Unmarshal(data, &one); err == nil {
+		*s = stringOrSlice{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*s = many
+	return nil
+}
+
+func ParseBucketPolicy(bucket string, data []byte) (*BucketPolicy, error) {
+	if len(data) > MaxBucketPolicySize {
+		return nil, ErrorMessage(ErrMalformedPolicy, "Policy exceeds the maximum allowed document size")
+	}
+	policy := &BucketPolicy{}
+	if err := json.Unmarshal(data, policy); err != nil {
+		return nil, ErrorMessage(ErrMalformedPolicy, err.Error())
+	}
+	if len(policy.Statement) == 0 {
+		return nil, ErrorMessage(ErrMalformedPolicy, "Missing required field Statement")
+	}
+	for _, st := range policy.Statement {
+		if st.Effect != PolicyEffectAllow && st.Effect != PolicyEffectDeny {
+			return nil, ErrorMessagef(ErrMalformedPolicy, "Invalid effect: %s", st.Effect)
+		}
+		if len(st.Principal.AWS) == 0 || len(st.Action) == 0 || len(st.Resource) == 0 {
+			return nil, ErrorMessage(ErrMalformedPolicy, "Statement requires Principal, Action and Resource")
+		}
+		for _, action := range st.Action {
+			if !strings.HasPrefix(action, "s3:") {
+				return nil, ErrorMessagef(ErrMalformedPolicy, "Action does not apply to any resource(s) in statement: %s", action)
+			}
+		}
+		for _, res := range st.Resource {
+			name := strings.TrimPrefix(res, resourceARNPrefix)
+			if name == res || (name != bucket && !strings.HasPrefix(name, bucket+"/")) {
+				return nil, ErrorMessagef(ErrMalformedPolicy, "Policy has invalid resource: %s", res)
+			}
+		}
+	}
+	return policy, nil
+}
+
+func resourceARN(bucket, object string) string {
+	if object == "" {
+		return resourceARNPrefix + bucket
+	}
+	return resourceARNPrefix + bucket + "/" + object
+}
+
+// Evaluate applies the statements in order; an explicit Deny always wins.
+func (p *BucketPolicy) Evaluate(principal, action, resource string) policyDecision {
+	decision := policyNone
+	for _, st := range p.Statement {
+		if !st.matchPrincipal(principal) || !matchAny(st.Action, action) || !matchAny(st.Resource, resource) {
+			continue
+		}
+		if st.Effect == PolicyEffectDeny {
+			return policyDeny
+		}
+		decision = policyAllow
+	}
+	return decision
+}
+
+func (st *PolicyStatement) matchPrincipal(principal string) bool {
+	for _, p := range st.Principal.AWS {
+		if p == "*" {
+			return true
+		}
+		if principal != "" && strings.TrimPrefix(p, accessKeyPrefix) == principal {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, s string) bool {
+	for _, p := range patterns {
+		if wildcardMatch(p, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// wildcardMatch matches s against a pattern of '*' and '?'. On a mismatch it
+// only backtracks to the last star, so a pattern is matched in O(len(pattern)*len(s)).
+func wildcardMatch(pattern, s string) bool {
+	p, i := 0, 0
+	star, next := -1, 0
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			star, next = p, i
+			p++
+		case star >= 0:
+			next++
+			p, i = star+1, next
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
+
+func (g *Server) routePolicy(bucket string, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getBucketPolicy(bucket, w, r)
+	case "PUT":
+		return g.putBucketPolicy(bucket, w, r)
+	case "DELETE":
+		return g.deleteBucketPolicy(bucket, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getBucketPolicy(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.acl == nil {
+		return ResourceError(ErrNoSuchBucketPolicy, bucket)
+	}
+	data, err := g.acl.BucketPolicy(accesskey, bucket)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, err = w.Write(data)
+	return err
+}
+
+func (g *Server) putBucketPolicy(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.acl == nil {
+		return ErrNotImplemented
+	}
+	defer r.Body.Close()
+	data, err := ioutil.ReadAll(io.LimitReader(r.Body, MaxBucketPolicySize+1))
+	if err != nil {
+		return err
+	}
+	policy, err := ParseBucketPolicy(bucket, data)
+	if err != nil {
+		return err
+	}
+	logrus.Infof("[S3]PUT POLICY:%s,statements:%d\n", bucket, len(policy.Statement))
+	if err := g.acl.SetBucketPolicy(accesskey, bucket, data); err != nil {
+		return err
+	}
+	g.accessChanged(accesskey, bucket)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (g *Server) deleteBucketPolicy(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.acl != nil {
+		if err := g.acl.DeleteBucketPolicy(accesskey, bucket); err != nil {
+			return err
+		}
+		g.accessChanged(accesskey, bucket)
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
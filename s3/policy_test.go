@@ -0,0 +1,230 @@
+package s3
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWildcardMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		match   bool
+	}{
+		{"", "", true},
+		{"", "a", false},
+		{"*", "", true},
+		{"*", "anything", true},
+		{"?", "", false},
+		{"?", "a", true},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket/dir/key", true},
+		{"arn:aws:s3:::bucket/*", "arn:aws:s3:::bucket", false},
+		{"arn:aws:s3:::bucket*", "arn:aws:s3:::bucket", true},
+		{"arn:aws:s3:::bucket/*.jpg", "arn:aws:s3:::bucket/a/b.jpg", true},
+		{"arn:aws:s3:::bucket/*.jpg", "arn:aws:s3:::bucket/a/b.jpg.png", false},
+		{"s3:Get*", "s3:GetObject", true},
+		{"s3:Get*", "s3:PutObject", false},
+		{"a*b*c", "abc", true},
+		{"a*b*c", "axxbyyc", true},
+		{"a*b*c", "axxbyyca", false},
+		{"*a*", "bab", true},
+		{"**", "x", true},
+		{"a*?", "a", false},
+		{"a*?", "ab", true},
+	}
+	for _, tt := range tests {
+		if got := wildcardMatch(tt.pattern, tt.s); got != tt.match {
+			t.Errorf("wildcardMatch(%q, %q) = %v", tt.pattern, tt.s, got)
+		}
+	}
+}
+
+func TestWildcardMatchManyStars(t *testing.T) {
+	pattern := strings.Repeat("a*", 30) + "b"
+	s := strings.Repeat("a", 5000)
+	start := time.Now()
+	if wildcardMatch(pattern, s) {
+		t.Fatal("pattern matched")
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Fatalf("match took %s", d)
+	}
+}
+
+func TestParseBucketPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		valid  bool
+	}{
+		{"allow", `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`, true},
+		{"principal list", `{"Statement":[{"Effect":"Deny","Principal":{"AWS":["YTAkey"]},"Action":["s3:GetObject","s3:ListBucket"],"Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"]}]}`, true},
+		{"no statement", `{"Statement":[]}`, false},
+		{"effect", `{"Statement":[{"Effect":"Maybe","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`, false},
+		{"principal", `{"Statement":[{"Effect":"Allow","Principal":"someone","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/*"}]}`, false},
+		{"action", `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"iam:GetUser","Resource":"arn:aws:s3:::bucket/*"}]}`, false},
+		{"other bucket", `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket2/*"}]}`, false},
+		{"not an arn", `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"bucket/*"}]}`, false},
+		{"json", `{"Statement":`, false},
+	}
+	for _, tt := range tests {
+		_, err := ParseBucketPolicy("bucket", []byte(tt.policy))
+		if (err == nil) != tt.valid {
+			t.Errorf("%s: error %v", tt.name, err)
+		}
+	}
+}
+
+func TestBucketPolicyEvaluate(t *testing.T) {
+	policy, err := ParseBucketPolicy("bucket", []byte(`{"Statement":[
+		{"Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::bucket/public/*"},
+		{"Effect":"Allow","Principal":{"AWS":"YTAreader"},"Action":["s3:Get*","s3:ListBucket"],"Resource":["arn:aws:s3:::bucket","arn:aws:s3:::bucket/*"]},
+		{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"arn:aws:s3:::bucket/public/secret*"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		principal string
+		action    string
+		resource  string
+		decision  policyDecision
+	}{
+		{"anonymous public", "", ActionGetObject, resourceARN("bucket", "public/a"), policyAllow},
+		{"anonymous private", "", ActionGetObject, resourceARN("bucket", "private/a"), policyNone},
+		{"anonymous put", "", ActionPutObject, resourceARN("bucket", "public/a"), policyNone},
+		{"anonymous deny", "", ActionGetObject, resourceARN("bucket", "public/secret.txt"), policyDeny},
+		{"reader", "reader", ActionGetObjectTagging, resourceARN("bucket", "private/a"), policyAllow},
+		{"reader list", "reader", ActionListBucket, resourceARN("bucket", ""), policyAllow},
+		{"reader deny wins", "reader", ActionGetObject, resourceARN("bucket", "public/secret"), policyDeny},
+		{"other user", "other", ActionListBucket, resourceARN("bucket", ""), policyNone},
+	}
+	for _, tt := range tests {
+		if got := policy.Evaluate(tt.principal, tt.action, tt.resource); got != tt.decision {
+			t.Errorf("%s: decision %d, expected %d", tt.name, got, tt.decision)
+		}
+	}
+}
+
+func TestCannedACLGrantsRead(t *testing.T) {
+	tests := []struct {
+		acl       CannedACL
+		principal string
+		read      bool
+	}{
+		{ACLPrivate, "", false},
+		{ACLPrivate, "user", false},
+		{ACLPublicRead, "", true},
+		{ACLPublicRead, "user", true},
+		{ACLAuthenticatedRead, "", false},
+		{ACLAuthenticatedRead, "user", true},
+		{"", "user", false},
+	}
+	for _, tt := range tests {
+		if got := tt.acl.GrantsRead(tt.principal); got != tt.read {
+			t.Errorf("%s.GrantsRead(%q) = %v", tt.acl, tt.principal, got)
+		}
+	}
+}
+
+type testACLBackend struct {
+	bucket  map[string]CannedACL
+	objects map[string]CannedACL
+	policy  map[string]string
+}
+
+func (b *testACLBackend) BucketACL(accesskey, bucket string) (CannedACL, error) {
+	return b.bucket[bucket], nil
+}
+
+func (b *testACLBackend) SetBucketACL(accesskey, bucket string, acl CannedACL) error {
+	b.bucket[bucket] = acl
+	return nil
+}
+
+func (b *testACLBackend) ObjectACL(accesskey, bucket, object string, versionID VersionID) (CannedACL, error) {
+	return b.objects[bucket+"/"+object+"?"+string(versionID)], nil
+}
+
+func (b *testACLBackend) SetObjectACL(accesskey, bucket, object string, versionID VersionID, acl CannedACL) error {
+	b.objects[bucket+"/"+object+"?"+string(versionID)] = acl
+	return nil
+}
+
+func (b *testACLBackend) BucketPolicy(accesskey, bucket string) ([]byte, error) {
+	if p, ok := b.policy[bucket]; ok {
+		return []byte(p), nil
+	}
+	return nil, ErrNoSuchBucketPolicy
+}
+
+func (b *testACLBackend) SetBucketPolicy(accesskey, bucket string, policy []byte) error {
+	b.policy[bucket] = string(policy)
+	return nil
+}
+
+func (b *testACLBackend) DeleteBucketPolicy(accesskey, bucket string) error {
+	delete(b.policy, bucket)
+	return nil
+}
+
+func TestAllowed(t *testing.T) {
+	backend := &testACLBackend{
+		bucket: map[string]CannedACL{
+			"allowed-public":  ACLPublicRead,
+			"allowed-members": ACLAuthenticatedRead,
+		},
+		objects: map[string]CannedACL{
+			"allowed-private/latest?":  ACLPublicRead,
+			"allowed-private/old?v1":   ACLPublicRead,
+			"allowed-private/members?": ACLAuthenticatedRead,
+			"allowed-policy/open?":     ACLPublicRead,
+			"allowed-policy/other?":    ACLPublicRead,
+		},
+		policy: map[string]string{
+			"allowed-policy": `{"Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::allowed-policy/open"},
+				{"Effect":"Allow","Principal":"*","Action":"s3:PutObject","Resource":"arn:aws:s3:::allowed-policy/*"}]}`,
+		},
+	}
+	g := &Server{acl: backend}
+	tests := []struct {
+		name      string
+		principal string
+		bucket    string
+		object    string
+		versionID VersionID
+		action    string
+		allowed   bool
+	}{
+		{"public bucket", "", "allowed-public", "a", "", ActionGetObject, true},
+		{"public bucket list", "", "allowed-public", "", "", ActionListBucket, true},
+		{"public bucket put", "", "allowed-public", "a", "", ActionPutObject, false},
+		{"public bucket config", "user", "allowed-public", "", "", actionOwner, false},
+		{"members bucket anonymous", "", "allowed-members", "a", "", ActionGetObject, false},
+		{"members bucket", "user", "allowed-members", "a", "", ActionGetObject, true},
+		{"private bucket", "", "allowed-private", "a", "", ActionGetObject, false},
+		{"public object", "", "allowed-private", "latest", "", ActionGetObject, true},
+		{"public object other version", "", "allowed-private", "latest", "v1", ActionGetObject, false},
+		{"public old version", "", "allowed-private", "old", "v1", ActionGetObject, true},
+		{"old version latest", "", "allowed-private", "old", "", ActionGetObject, false},
+		{"members object", "user", "allowed-private", "members", "", ActionGetObject, true},
+		{"members object anonymous", "", "allowed-private", "members", "", ActionGetObject, false},
+		{"policy deny over acl", "", "allowed-policy", "open", "", ActionGetObject, false},
+		{"policy allow", "", "allowed-policy", "new", "", ActionPutObject, true},
+	}
+	for _, tt := range tests {
+		if got := g.allowed(tt.principal, "owner", tt.bucket, tt.object, tt.versionID, tt.action); got != tt.allowed {
+			t.Errorf("%s: allowed = %v", tt.name, got)
+		}
+	}
+
+	if err := g.setACL("owner", "allowed-private", "old", "v1", ACLPrivate); err != nil {
+		t.Fatal(err)
+	}
+	if g.allowed("", "owner", "allowed-private", "old", "v1", ActionGetObject) {
+		t.Error("cached object ACL survived setACL")
+	}
+}
@@ -0,0 +1,138 @@
+package s3
+
+import (
+	"crypto/hmac"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const MaxPresignExpires = 7 * 24 * time.Hour
+
+func isPresigned(r *http.Request) bool {
+	_, ok := r.URL.Query()["X-Amz-Algorithm"]
+	return ok
+}
+
+func presignAllowed(r *http.Request) bool {
+	path := strings.Trim(r.URL.Path, "/")
+	if parts := strings.SplitN(path, "/", 2); len(parts) != 2 || parts[1] == "" {
+		return false
+	}
+	if r.Header.Get("X-Amz-Copy-Source") != "" {
+		return false
+	}
+	query := r.URL.Query()
+	for k := range query {
+		switch {
+		case strings.HasPrefix(k, "X-Amz-"), strings.HasPrefix(k, "response-"):
+		case k == "versionId":
+		case k == "uploadId", k == "partNumber":
+			if r.Method != "PUT" || query.Get("uploadId") == "" || query.Get("partNumber") == "" {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	switch r.Method {
+	case "GET", "HEAD", "PUT":
+		return true
+	default:
+		return false
+	}
+}
+
+func (g *Server) authenticatePresigned(r *http.Request) (string, error) {
+	if !presignAllowed(r) {
+		return "", ErrAccessDenied
+	}
+	query := r.URL.Query()
+	if query.Get("X-Amz-Algorithm") != signV4Algorithm {
+		return "", ErrInvalidQuerySignatureAlgo
+	}
+	cred, err := parseCredential(query.Get("X-Amz-Credential"))
+	if err != nil {
+		return "", err
+	}
+	signedHeaders, err := parseSignedHeaders(query.Get("X-Amz-SignedHeaders"))
+	if err != nil {
+		return "", err
+	}
+	if !containsString(signedHeaders, "host") {
+		return "", ErrMissingRequiredSignedHeader
+	}
+	sig := query.Get("X-Amz-Signature")
+	if sig == "" {
+		return "", ErrMissingSignTag
+	}
+	amzdate := query.Get("X-Amz-Date")
+	t, err := time.Parse(iso8601Format, amzdate)
+	if err != nil {
+		return "", ErrMalformedDate
+	}
+	if t.Format(yyyymmdd) != cred.date {
+		return "", ErrorMessage(ErrAuthorizationHeaderMalformed, "credential date does not match request date")
+	}
+	expires, err := strconv.ParseInt(query.Get("X-Amz-Expires"), 10, 64)
+	if err != nil || expires <= 0 || time.Duration(expires)*time.Second > MaxPresignExpires {
+		return "", ErrMalformedExpires
+	}
+	now := g.timeSource.Now()
+	if t.Sub(now) > MaxRequestSkew {
+		return "", RequestTimeTooSkewed(now, MaxRequestSkew)
+	}
+	if now.After(t.Add(time.Duration(expires) * time.Second)) {
+		return "", ErrExpiredPresignRequest
+	}
+	accesskey := strings.TrimPrefix(cred.accessKey, accessKeyPrefix)
+	secret, err := g.auth.SecretKey(accesskey)
+	if err != nil {
+		return "", err
+	}
+	payload := query.Get("X-Amz-Content-Sha256")
+	if payload == "" {
+		payload = unsignedPayload
+	}
+	canonical := canonicalRequest(r, query, signedHeaders, payload)
+	expected := signature(signingKey(secret, cred), stringToSign(amzdate, cred, canonical))
+	if !hmac.Equal([]byte(expected), []byte(sig)) {
+		return "", ErrSignatureDoesNotMatch
+	}
+	return accesskey, nil
+}
+
+func PresignV4(method, rawurl, accesskey, secret, region string, at time.Time, expires time.Duration) (string, error) {
+	if expires <= 0 || expires > MaxPresignExpires {
+		return "", ErrMalformedExpires
+	}
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		return "", err
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+	at = at.UTC()
+	cred := credentialScope{
+		accessKey: accesskey,
+		date:      at.Format(yyyymmdd),
+		region:    region,
+		service:   signV4Service,
+		request:   signV4RequestSuffix,
+	}
+	amzdate := at.Format(iso8601Format)
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", signV4Algorithm)
+	query.Set("X-Amz-Credential", cred.accessKey+"/"+cred.String())
+	query.Set("X-Amz-Date", amzdate)
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(expires/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", "host")
+	req := &http.Request{Method: method, URL: u, Host: u.Host, Header: http.Header{}}
+	canonical := canonicalRequest(req, query, []string{"host"}, unsignedPayload)
+	query.Set("X-Amz-Signature", signature(signingKey(secret, cred), stringToSign(amzdate, cred, canonical)))
+	u.RawQuery = canonicalQuery(query) + "&X-Amz-Signature=" + query.Get("X-Amz-Signature")
+	return u.String(), nil
+}
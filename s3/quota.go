@@ -0,0 +1,70 @@
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+)
+
+// BucketQuota caps the bytes and the number of object versions stored in a
+// bucket. Zero means unlimited.
+type BucketQuota struct {
+	XMLName    xml.Name `xml:"BucketQuota"`
+	MaxSize    int64    `xml:"MaxSizeBytes,omitempty"`
+	MaxObjects int64    `xml:"MaxObjects,omitempty"`
+}
+
+var errQuotaReadOnly = ErrorMessage(ErrAccessDenied, "Bucket quotas are set by the storage operator")
+
+// QuotaBackend reads per-bucket quotas, enforced by the SN when an object is
+// written. Quotas are shown through the non-standard "?quota" bucket
+// subresource.
+type QuotaBackend interface {
+	BucketQuota(accesskey string, bucket string) (*BucketQuota, error)
+}
+
+func (g *Server) routeQuota(bucket string, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getBucketQuota(bucket, w, r)
+	case "PUT":
+		return g.putBucketQuota(bucket, w, r)
+	case "DELETE":
+		return g.deleteBucketQuota(bucket, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getBucketQuota(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.quota == nil {
+		return ErrNotImplemented
+	}
+	quota, err := g.quota.BucketQuota(accesskey, bucket)
+	if err != nil {
+		return err
+	}
+	return g.xmlEncoder(w).Encode(quota)
+}
+
+// Owners may read the quota of their buckets but not change it; the SN
+// operator sets quotas through its /bucketquota API.
+func (g *Server) putBucketQuota(bucket string, w http.ResponseWriter, r *http.Request) error {
+	if _, autherr := g.authorize(r, bucket, "", actionOwner); autherr != nil {
+		return autherr
+	}
+	return errQuotaReadOnly
+}
+
+func (g *Server) deleteBucketQuota(bucket string, w http.ResponseWriter, r *http.Request) error {
+	if _, autherr := g.authorize(r, bucket, "", actionOwner); autherr != nil {
+		return autherr
+	}
+	return errQuotaReadOnly
+}
@@ -3,6 +3,7 @@ package s3
 import (
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -13,13 +14,18 @@ type ObjectRange struct {
 
 func (o *ObjectRange) writeHeader(sz int64, w http.ResponseWriter) {
 	if o != nil {
-		w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", o.Start, o.Start+o.Length-1, sz))
+		w.Header().Set("Content-Range", o.contentRange(sz))
 		w.Header().Set("Content-Length", fmt.Sprintf("%d", o.Length))
+		w.WriteHeader(http.StatusPartialContent)
 	} else {
 		w.Header().Set("Content-Length", fmt.Sprintf("%d", sz))
 	}
 }
 
+func (o *ObjectRange) contentRange(sz int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", o.Start, o.Start+o.Length-1, sz)
+}
+
 type ObjectRangeRequest struct {
 	Start, End int64
 	FromEnd    bool
@@ -45,8 +51,13 @@ func (o *ObjectRangeRequest) Range(size int64) (*ObjectRange, error) {
 		}
 
 	} else {
-		end := o.End
-		start = size - end
+		if o.End <= 0 {
+			return nil, ErrInvalidRange
+		}
+		start = size - o.End
+		if start < 0 {
+			start = 0
+		}
 		length = size - start
 	}
 
@@ -61,7 +72,37 @@ func (o *ObjectRangeRequest) Range(size int64) (*ObjectRange, error) {
 	return &ObjectRange{Start: start, Length: length}, nil
 }
 
+// coalesceRanges sorts ranges and merges those that overlap or touch.
+func coalesceRanges(ranges []*ObjectRange) []*ObjectRange {
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].Start < ranges[j].Start })
+	var merged []*ObjectRange
+	for _, rnge := range ranges {
+		if n := len(merged); n > 0 {
+			last := merged[n-1]
+			if rnge.Start <= last.Start+last.Length {
+				if end := rnge.Start + rnge.Length; end > last.Start+last.Length {
+					last.Length = end - last.Start
+				}
+				continue
+			}
+		}
+		merged = append(merged, &ObjectRange{Start: rnge.Start, Length: rnge.Length})
+	}
+	return merged
+}
+
 func parseRangeHeader(s string) (*ObjectRangeRequest, error) {
+	ranges, err := parseRangesHeader(s)
+	if err != nil || ranges == nil {
+		return nil, err
+	}
+	if len(ranges) > 1 {
+		return nil, ErrorMessage(ErrNotImplemented, "multiple ranges not supported")
+	}
+	return ranges[0], nil
+}
+
+func parseRangesHeader(s string) ([]*ObjectRangeRequest, error) {
 	if s == "" {
 		return nil, nil
 	}
@@ -71,12 +112,18 @@ func parseRangeHeader(s string) (*ObjectRangeRequest, error) {
 		return nil, ErrInvalidRange
 	}
 
-	ranges := strings.Split(s[len(b):], ",")
-	if len(ranges) > 1 {
-		return nil, ErrorMessage(ErrNotImplemented, "multiple ranges not supported")
+	var ranges []*ObjectRangeRequest
+	for _, rnge := range strings.Split(s[len(b):], ",") {
+		o, err := parseRange(strings.TrimSpace(rnge))
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, o)
 	}
+	return ranges, nil
+}
 
-	rnge := strings.TrimSpace(ranges[0])
+func parseRange(rnge string) (*ObjectRangeRequest, error) {
 	if len(rnge) == 0 {
 		return nil, ErrInvalidRange
 	}
@@ -0,0 +1,107 @@
+package s3
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRangesHeader(t *testing.T) {
+	tests := []struct {
+		header string
+		ranges []*ObjectRangeRequest
+		err    bool
+	}{
+		{"", nil, false},
+		{"bytes=0-99", []*ObjectRangeRequest{{Start: 0, End: 99}}, false},
+		{"bytes=100-", []*ObjectRangeRequest{{Start: 100, End: RangeNoEnd}}, false},
+		{"bytes=-500", []*ObjectRangeRequest{{End: 500, FromEnd: true}}, false},
+		{"bytes=0-0, 10-19,-5", []*ObjectRangeRequest{{Start: 0, End: 0}, {Start: 10, End: 19}, {End: 5, FromEnd: true}}, false},
+		{"bytes= 5 - 9 ", []*ObjectRangeRequest{{Start: 5, End: 9}}, false},
+		{"items=0-99", nil, true},
+		{"bytes=", nil, true},
+		{"bytes=0-99,", nil, true},
+		{"bytes=10", nil, true},
+		{"bytes=9-5", nil, true},
+		{"bytes=a-5", nil, true},
+		{"bytes=0-b", nil, true},
+		{"bytes=-x", nil, true},
+	}
+	for _, tt := range tests {
+		ranges, err := parseRangesHeader(tt.header)
+		if (err != nil) != tt.err {
+			t.Errorf("%q: error %v", tt.header, err)
+			continue
+		}
+		if !reflect.DeepEqual(ranges, tt.ranges) {
+			t.Errorf("%q: got %+v", tt.header, ranges)
+		}
+	}
+}
+
+func TestParseRangeHeaderSingle(t *testing.T) {
+	if _, err := parseRangeHeader("bytes=0-1,5-6"); !HasErrorCode(err, ErrNotImplemented) {
+		t.Errorf("multiple ranges: %v", err)
+	}
+	if o, err := parseRangeHeader(""); o != nil || err != nil {
+		t.Errorf("no range: %+v %v", o, err)
+	}
+}
+
+func TestObjectRangeRequestRange(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *ObjectRangeRequest
+		size int64
+		rnge *ObjectRange
+		err  bool
+	}{
+		{"none", nil, 100, nil, false},
+		{"first", &ObjectRangeRequest{Start: 0, End: 9}, 100, &ObjectRange{Start: 0, Length: 10}, false},
+		{"middle", &ObjectRangeRequest{Start: 10, End: 19}, 100, &ObjectRange{Start: 10, Length: 10}, false},
+		{"past end", &ObjectRangeRequest{Start: 90, End: 199}, 100, &ObjectRange{Start: 90, Length: 10}, false},
+		{"open", &ObjectRangeRequest{Start: 95, End: RangeNoEnd}, 100, &ObjectRange{Start: 95, Length: 5}, false},
+		{"suffix", &ObjectRangeRequest{End: 10, FromEnd: true}, 100, &ObjectRange{Start: 90, Length: 10}, false},
+		{"suffix over size", &ObjectRangeRequest{End: 500, FromEnd: true}, 100, &ObjectRange{Start: 0, Length: 100}, false},
+		{"zero suffix", &ObjectRangeRequest{End: 0, FromEnd: true}, 100, nil, true},
+		{"start at size", &ObjectRangeRequest{Start: 100, End: RangeNoEnd}, 100, nil, true},
+		{"empty object", &ObjectRangeRequest{Start: 0, End: 0}, 0, nil, true},
+	}
+	for _, tt := range tests {
+		rnge, err := tt.req.Range(tt.size)
+		if (err != nil) != tt.err {
+			t.Errorf("%s: error %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(rnge, tt.rnge) {
+			t.Errorf("%s: got %+v, expected %+v", tt.name, rnge, tt.rnge)
+		}
+	}
+}
+
+func TestCoalesceRanges(t *testing.T) {
+	tests := []struct {
+		name   string
+		ranges []*ObjectRange
+		merged []*ObjectRange
+	}{
+		{"one", []*ObjectRange{{0, 10}}, []*ObjectRange{{0, 10}}},
+		{"apart", []*ObjectRange{{0, 10}, {20, 5}}, []*ObjectRange{{0, 10}, {20, 5}}},
+		{"unsorted", []*ObjectRange{{20, 5}, {0, 10}}, []*ObjectRange{{0, 10}, {20, 5}}},
+		{"touching", []*ObjectRange{{0, 10}, {10, 5}}, []*ObjectRange{{0, 15}}},
+		{"overlapping", []*ObjectRange{{0, 10}, {5, 10}}, []*ObjectRange{{0, 15}}},
+		{"contained", []*ObjectRange{{0, 100}, {10, 5}, {50, 50}}, []*ObjectRange{{0, 100}}},
+		{"chain", []*ObjectRange{{30, 10}, {0, 10}, {8, 25}, {60, 1}}, []*ObjectRange{{0, 40}, {60, 1}}},
+	}
+	for _, tt := range tests {
+		if got := coalesceRanges(tt.ranges); !reflect.DeepEqual(got, tt.merged) {
+			t.Errorf("%s: got %+v", tt.name, got)
+		}
+	}
+}
+
+func TestObjectRangeContentRange(t *testing.T) {
+	o := &ObjectRange{Start: 10, Length: 5}
+	if got := o.contentRange(100); got != "bytes 10-14/100" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -41,9 +41,39 @@ func (g *Server) routeBase(w http.ResponseWriter, r *http.Request) {
 	} else if _, ok := query["versioning"]; ok {
 		err = g.routeVersioning(bucket, w, r)
 
+	} else if _, ok := query["lifecycle"]; ok && object == "" {
+		err = g.routeLifecycle(bucket, w, r)
+
 	} else if _, ok := query["versions"]; ok {
 		err = g.routeVersions(bucket, w, r)
 
+	} else if _, ok := query["acl"]; ok && bucket != "" {
+		err = g.routeACL(bucket, object, VersionID(versionFromQuery(query["versionId"])), w, r)
+
+	} else if _, ok := query["policy"]; ok && bucket != "" && object == "" {
+		err = g.routePolicy(bucket, w, r)
+
+	} else if _, ok := query["website"]; ok && bucket != "" && object == "" {
+		err = g.routeWebsiteConfig(bucket, w, r)
+
+	} else if _, ok := query["quota"]; ok && bucket != "" && object == "" {
+		err = g.routeQuota(bucket, w, r)
+
+	} else if _, ok := query["notification"]; ok && bucket != "" && object == "" {
+		err = g.routeNotification(bucket, w, r)
+
+	} else if _, ok := query["object-lock"]; ok && bucket != "" && object == "" {
+		err = g.routeObjectLock(bucket, w, r)
+
+	} else if _, ok := query["retention"]; ok && object != "" {
+		err = g.routeRetention(bucket, object, VersionID(versionFromQuery(query["versionId"])), w, r)
+
+	} else if _, ok := query["legal-hold"]; ok && object != "" {
+		err = g.routeLegalHold(bucket, object, VersionID(versionFromQuery(query["versionId"])), w, r)
+
+	} else if _, ok := query["tagging"]; ok && object != "" {
+		err = g.routeTagging(bucket, object, VersionID(versionFromQuery(query["versionId"])), w, r)
+
 	} else if versionID := versionFromQuery(query["versionId"]); versionID != "" {
 		err = g.routeVersion(bucket, object, VersionID(versionID), w, r)
 
@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"math"
+	"mime/multipart"
 	"net/http"
 	"net/textproto"
 	"net/url"
@@ -16,14 +17,24 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/api/cache"
 	"github.com/yottachain/YTCoreService/env"
 )
 
 type Server struct {
 	requestID *env.AtomInt64
 
-	storage   Backend
-	versioned VersionedBackend
+	storage      Backend
+	versioned    VersionedBackend
+	lifecycle    LifecycleBackend
+	tagging      TaggingBackend
+	acl          AccessControlBackend
+	website      WebsiteBackend
+	encryption   EncryptionBackend
+	quota        QuotaBackend
+	objectLock   ObjectLockBackend
+	notification NotificationBackend
+	auth         AuthBackend
 
 	timeSource              TimeSource
 	metadataSizeLimit       int
@@ -42,9 +53,22 @@ func NewS3(backend Backend) *Server {
 		requestID:         env.NewAtomInt64(0),
 	}
 	s3.versioned, _ = backend.(VersionedBackend)
+	s3.lifecycle, _ = backend.(LifecycleBackend)
+	s3.tagging, _ = backend.(TaggingBackend)
+	s3.acl, _ = backend.(AccessControlBackend)
+	s3.website, _ = backend.(WebsiteBackend)
+	s3.encryption, _ = backend.(EncryptionBackend)
+	s3.quota, _ = backend.(QuotaBackend)
+	s3.objectLock, _ = backend.(ObjectLockBackend)
+	s3.notification, _ = backend.(NotificationBackend)
+	s3.auth, _ = backend.(AuthBackend)
 	if s3.timeSource == nil {
 		s3.timeSource = DefaultTimeSource()
 	}
+	cache.KeepDirs = s3.uploader.ActiveDirs
+	if MultipartJanitorInterval > 0 {
+		go s3.uploadJanitor()
+	}
 	return s3
 }
 
@@ -62,7 +86,7 @@ func (g *Server) httpError(w http.ResponseWriter, r *http.Request, err error) {
 		logrus.Errorf("[S3]ERR:%s", err)
 	}
 	w.WriteHeader(resp.ErrorCode().Status())
-	if r.Method != http.MethodHead {
+	if r.Method != http.MethodHead && resp.ErrorCode() != ErrNotModified {
 		if err := g.xmlEncoder(w).Encode(resp); err != nil {
 			logrus.Errorf("[S3]ERR:%s", err)
 			return
@@ -72,7 +96,7 @@ func (g *Server) httpError(w http.ResponseWriter, r *http.Request, err error) {
 
 func (g *Server) listBuckets(w http.ResponseWriter, r *http.Request) error {
 	logrus.Debugln("[S3]LIST BUCKETS")
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authenticate(r)
 	if autherr != nil {
 		return autherr
 	}
@@ -94,7 +118,7 @@ func (g *Server) listBuckets(w http.ResponseWriter, r *http.Request) error {
 
 func (g *Server) listBucket(bucketName string, w http.ResponseWriter, r *http.Request) error {
 	logrus.Debugln("[S3]LIST BUCKET")
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucketName, "", ActionListBucket)
 	if autherr != nil {
 		return autherr
 	}
@@ -165,7 +189,7 @@ func (g *Server) listBucket(bucketName string, w http.ResponseWriter, r *http.Re
 
 func (g *Server) getBucketLocation(bucketName string, w http.ResponseWriter, r *http.Request) error {
 	logrus.Debugln("[S3]GET BUCKET LOCATION")
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucketName, "", ActionGetBucketLocation)
 	if autherr != nil {
 		return autherr
 	}
@@ -183,7 +207,7 @@ func (g *Server) listBucketVersions(bucketName string, w http.ResponseWriter, r
 	if g.versioned == nil {
 		return ErrNotImplemented
 	}
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucketName, "", ActionListBucketVersions)
 	if autherr != nil {
 		return autherr
 	}
@@ -220,16 +244,34 @@ func (g *Server) listBucketVersions(bucketName string, w http.ResponseWriter, r
 
 func (g *Server) createBucket(bucket string, w http.ResponseWriter, r *http.Request) error {
 	logrus.Infof("[S3]CREATE BUCKET:%s", bucket)
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authenticate(r)
 	if autherr != nil {
 		return autherr
 	}
 	if err := ValidateBucketName(bucket); err != nil {
 		return err
 	}
+	acl, err := ParseCannedACL(r.Header.Get("X-Amz-Acl"))
+	if err != nil {
+		return err
+	}
+	lock := strings.EqualFold(r.Header.Get("X-Amz-Bucket-Object-Lock-Enabled"), "true")
+	if lock && g.objectLock == nil {
+		return ErrNotImplemented
+	}
 	if err := g.storage.CreateBucket(accesskey, bucket); err != nil {
 		return err
 	}
+	if acl != ACLPrivate && g.acl != nil {
+		if err := g.setACL(accesskey, bucket, "", "", acl); err != nil {
+			return err
+		}
+	}
+	if lock {
+		if err := g.objectLock.SetObjectLockConfiguration(accesskey, bucket, &ObjectLockConfiguration{ObjectLockEnabled: "Enabled"}); err != nil {
+			return err
+		}
+	}
 	w.Header().Set("Location", "/"+bucket)
 	w.Write([]byte{})
 	return nil
@@ -237,7 +279,7 @@ func (g *Server) createBucket(bucket string, w http.ResponseWriter, r *http.Requ
 
 func (g *Server) deleteBucket(bucket string, w http.ResponseWriter, r *http.Request) error {
 	logrus.Infof("[S3]DELETE BUCKET:%s", bucket)
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
 	if autherr != nil {
 		return autherr
 	}
@@ -247,13 +289,15 @@ func (g *Server) deleteBucket(bucket string, w http.ResponseWriter, r *http.Requ
 	if err := g.storage.DeleteBucket(accesskey, bucket); err != nil {
 		return err
 	}
+	cache.DeleteBucketOwner(bucket, accesskey)
+	cache.DeleteWebsiteOwner(bucket, accesskey)
 	w.WriteHeader(http.StatusNoContent)
 	return nil
 }
 
 func (g *Server) headBucket(bucket string, w http.ResponseWriter, r *http.Request) error {
 	logrus.Debugf("[S3]HEAD BUCKET:%s", bucket)
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, "", ActionListBucket)
 	if autherr != nil {
 		return autherr
 	}
@@ -266,39 +310,52 @@ func (g *Server) headBucket(bucket string, w http.ResponseWriter, r *http.Reques
 
 func (g *Server) getObject(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
 	logrus.Debugf("[S3]GET OBJECT:/%s/%s", bucket, object)
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, object, ActionGetObject)
 	if autherr != nil {
 		return autherr
 	}
 	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
 		return err
 	}
-	rnge, err := parseRangeHeader(r.Header.Get("Range"))
+	ranges, err := parseRangesHeader(r.Header.Get("Range"))
 	if err != nil {
 		return err
 	}
-	var obj *Object
-	{
+	key, err := customerKeyFromHeader(r.Header)
+	if err != nil {
+		return err
+	}
+	fetch := func(versionID VersionID, rnge *ObjectRangeRequest) (*Object, error) {
+		if key != nil {
+			return g.getObjectWithCustomerKey(accesskey, bucket, object, versionID, key, rnge)
+		}
 		if versionID == "" {
-			obj, err = g.storage.GetObject(accesskey, bucket, object, rnge)
-			if err != nil {
-				return err
-			}
-		} else {
-			if g.versioned == nil {
-				return ErrNotImplemented
-			}
-			obj, err = g.versioned.GetObjectVersion(accesskey, bucket, object, versionID, rnge)
-			if err != nil {
-				return err
-			}
+			return g.storage.GetObject(accesskey, bucket, object, rnge)
 		}
+		if g.versioned == nil {
+			return nil, ErrNotImplemented
+		}
+		return g.versioned.GetObjectVersion(accesskey, bucket, object, versionID, rnge)
+	}
+	if len(ranges) > 1 {
+		return g.getObjectRanges(bucket, object, versionID, ranges, key, fetch, w, r)
+	}
+	var rnge *ObjectRangeRequest
+	if len(ranges) == 1 {
+		rnge = ranges[0]
+	}
+	obj, err := fetch(versionID, rnge)
+	if err != nil {
+		return err
 	}
 	if obj == nil {
 		logrus.Errorf("[S3]Unexpected nil object for key:/%s/%s", bucket, object)
 		return ErrInternal
 	}
 	defer obj.Contents.Close()
+	if err := CheckCustomerKey(obj.Metadata, key); err != nil {
+		return err
+	}
 
 	if err := g.writeGetOrHeadObjectResponse(obj, w, r); err != nil {
 		return err
@@ -310,6 +367,101 @@ func (g *Server) getObject(bucket, object string, versionID VersionID, w http.Re
 	return nil
 }
 
+// getObjectRanges answers a multi-range GET with a multipart/byteranges body,
+// fetching each merged part separately so only the blocks it covers are
+// read. All parts are read from the version the first lookup found.
+func (g *Server) getObjectRanges(bucket, object string, versionID VersionID, ranges []*ObjectRangeRequest, key *CustomerKey,
+	fetch func(VersionID, *ObjectRangeRequest) (*Object, error), w http.ResponseWriter, r *http.Request) error {
+	obj, err := fetch(versionID, nil)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		logrus.Errorf("[S3]Unexpected nil object for key:/%s/%s", bucket, object)
+		return ErrInternal
+	}
+	obj.Contents.Close()
+	if err := CheckCustomerKey(obj.Metadata, key); err != nil {
+		return err
+	}
+	if obj.VersionID != "" && (g.versioned != nil || key != nil) {
+		versionID = obj.VersionID
+	}
+	var valid []*ObjectRange
+	for _, rr := range ranges {
+		rnge, err := rr.Range(obj.Size)
+		if err != nil {
+			continue
+		}
+		valid = append(valid, rnge)
+	}
+	if len(valid) == 0 {
+		return ErrInvalidRange
+	}
+	valid = coalesceRanges(valid)
+	var total int64
+	for _, rnge := range valid {
+		total = total + rnge.Length
+	}
+	if len(valid) == 1 || len(valid) > MaxObjectRanges || total >= obj.Size {
+		var rr *ObjectRangeRequest
+		if len(valid) == 1 && total < obj.Size {
+			rr = &ObjectRangeRequest{Start: valid[0].Start, End: valid[0].Start + valid[0].Length - 1}
+		}
+		one, err := fetch(versionID, rr)
+		if err != nil {
+			return err
+		}
+		defer one.Contents.Close()
+		if err := g.writeGetOrHeadObjectResponse(one, w, r); err != nil {
+			return err
+		}
+		one.Range.writeHeader(one.Size, w)
+		_, err = io.Copy(w, one.Contents)
+		return err
+	}
+	if err := g.writeGetOrHeadObjectResponse(obj, w, r); err != nil {
+		return err
+	}
+	etag := obj.etag()
+	contentType := w.Header().Get("Content-Type")
+	mw := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", "multipart/byteranges; boundary="+mw.Boundary())
+	w.Header().Del("Content-Length")
+	w.WriteHeader(http.StatusPartialContent)
+	for _, rnge := range valid {
+		rr := &ObjectRangeRequest{Start: rnge.Start, End: rnge.Start + rnge.Length - 1}
+		part, err := fetch(versionID, rr)
+		if err == nil && part == nil {
+			err = ErrInternal
+		}
+		if err == nil && part.etag() != etag {
+			part.Contents.Close()
+			err = ErrPreconditionFailed
+		}
+		if err != nil {
+			logrus.Errorf("[S3]GET OBJECT /%s/%s range %d-%d ERR:%s\n", bucket, object, rr.Start, rr.End, err)
+			return nil
+		}
+		h := textproto.MIMEHeader{}
+		if contentType != "" {
+			h.Set("Content-Type", contentType)
+		}
+		h.Set("Content-Range", part.Range.contentRange(part.Size))
+		pw, err := mw.CreatePart(h)
+		if err == nil {
+			_, err = io.Copy(pw, part.Contents)
+		}
+		part.Contents.Close()
+		if err != nil {
+			logrus.Errorf("[S3]GET OBJECT /%s/%s range %d-%d ERR:%s\n", bucket, object, rr.Start, rr.End, err)
+			return nil
+		}
+	}
+	mw.Close()
+	return nil
+}
+
 func (g *Server) writeGetOrHeadObjectResponse(obj *Object, w http.ResponseWriter, r *http.Request) error {
 	if obj.IsDeleteMarker {
 		w.Header().Set("x-amz-version-id", string(obj.VersionID))
@@ -322,10 +474,14 @@ func (g *Server) writeGetOrHeadObjectResponse(obj *Object, w http.ResponseWriter
 	if obj.VersionID != "" {
 		w.Header().Set("x-amz-version-id", string(obj.VersionID))
 	}
-	etag := `"` + hex.EncodeToString(obj.Hash) + `"`
+	etag := obj.etag()
 	w.Header().Set("ETag", etag)
-	if r.Header.Get("If-None-Match") == etag {
-		return ErrNotModified
+	modified := obj.modTime()
+	if !modified.IsZero() {
+		w.Header().Set("Last-Modified", formatHeaderTime(modified))
+	}
+	if err := checkConditions(r, etag, modified); err != nil {
+		return err
 	}
 	w.Header().Set("Accept-Ranges", "bytes")
 	return nil
@@ -334,14 +490,27 @@ func (g *Server) writeGetOrHeadObjectResponse(obj *Object, w http.ResponseWriter
 func (g *Server) headObject(bucket, object string, versionID VersionID,
 	w http.ResponseWriter, r *http.Request) error {
 	logrus.Infof("[S3]HEAD OBJECT:/%s/%s", bucket, object)
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, object, ActionGetObject)
 	if autherr != nil {
 		return autherr
 	}
 	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
 		return err
 	}
-	obj, err := g.storage.HeadObject(accesskey, bucket, object)
+	key, err := customerKeyFromHeader(r.Header)
+	if err != nil {
+		return err
+	}
+	var obj *Object
+	if key != nil {
+		obj, err = g.getObjectWithCustomerKey(accesskey, bucket, object, versionID, key, nil)
+	} else if versionID == "" {
+		obj, err = g.storage.HeadObject(accesskey, bucket, object)
+	} else if g.versioned == nil {
+		return ErrNotImplemented
+	} else {
+		obj, err = g.versioned.HeadObjectVersion(accesskey, bucket, object, versionID)
+	}
 	if err != nil {
 		return err
 	}
@@ -350,6 +519,9 @@ func (g *Server) headObject(bucket, object string, versionID VersionID,
 		return ErrInternal
 	}
 	defer obj.Contents.Close()
+	if err := CheckCustomerKey(obj.Metadata, key); err != nil {
+		return err
+	}
 
 	if err := g.writeGetOrHeadObjectResponse(obj, w, r); err != nil {
 		return err
@@ -360,7 +532,7 @@ func (g *Server) headObject(bucket, object string, versionID VersionID,
 
 func (g *Server) createObjectBrowserUpload(bucket string, w http.ResponseWriter, r *http.Request) error {
 	logrus.Debugln("[S3]CREATE OBJECT THROUGH BROWSER UPLOAD")
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, "", ActionPutObject)
 	if autherr != nil {
 		return autherr
 	}
@@ -411,23 +583,38 @@ func (g *Server) createObjectBrowserUpload(bucket string, w http.ResponseWriter,
 }
 
 func (g *Server) createObject(bucket, object string, w http.ResponseWriter, r *http.Request) (err error) {
-	if r.URL.RawQuery == "acl=" {
-		return nil
-	}
 	logrus.Infof("[S3]CREATED OBJECT:/%s/%s", bucket, object)
-	accesskey, autherr := GetAccessKey(r)
+	principal, accesskey, autherr := g.authorizeAs(r, bucket, object, ActionPutObject)
 	if autherr != nil {
 		return autherr
 	}
 	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
 		return err
 	}
+	acl, err := ParseCannedACL(r.Header.Get("X-Amz-Acl"))
+	if err != nil {
+		return err
+	}
 	meta, err := metadataHeaders(r.Header, g.timeSource.Now(), g.metadataSizeLimit)
 	if err != nil {
 		return err
 	}
+	key, err := customerKeyFromHeader(r.Header)
+	if err != nil {
+		return err
+	}
+	if key != nil && g.encryption == nil {
+		return ErrNotImplemented
+	}
+	if err := g.checkObjectLockHeaders(accesskey, bucket, meta); err != nil {
+		return err
+	}
+	defer lockConditionalWrite(r, bucket, object)()
+	if err := g.checkWriteConditions(accesskey, bucket, object, r); err != nil {
+		return err
+	}
 	if _, ok := meta["X-Amz-Copy-Source"]; ok {
-		return g.copyObject(bucket, object, meta, w, r)
+		return g.copyObject(principal, accesskey, bucket, object, meta, key, w, r)
 	}
 	contentLength := r.Header.Get("Content-Length")
 	if contentLength == "" {
@@ -448,18 +635,14 @@ func (g *Server) createObject(bucket, object string, w http.ResponseWriter, r *h
 			return ErrInvalidDigest
 		}
 	}
-	var reader io.Reader
-	if sha, ok := meta["X-Amz-Content-Sha256"]; ok && sha == "STREAMING-AWS4-HMAC-SHA256-PAYLOAD" {
-		reader = newChunkedReader(r.Body)
+	if meta["X-Amz-Content-Sha256"] == streamingPayload {
 		size, err = strconv.ParseInt(meta["X-Amz-Decoded-Content-Length"], 10, 64)
 		if err != nil {
 			w.WriteHeader(http.StatusBadRequest)
 			return nil
 		}
-	} else {
-		reader = r.Body
 	}
-	rdr, err := newHashingReader(reader, md5Base64)
+	rdr, err := newHashingReader(r.Body, md5Base64)
 	defer r.Body.Close()
 	if err != nil {
 		return err
@@ -468,36 +651,59 @@ func (g *Server) createObject(bucket, object string, w http.ResponseWriter, r *h
 	if err != nil {
 		return err
 	}
+	if acl != ACLPrivate && g.acl != nil {
+		if err := g.setACL(accesskey, bucket, object, result.VersionID, acl); err != nil {
+			return err
+		}
+	}
 	if result.VersionID != "" {
 		logrus.Infof("[S3]CREATED VERSION:/%s/%s/%s", bucket, object, result.VersionID)
 		w.Header().Set("x-amz-version-id", string(result.VersionID))
 	}
-	w.Header().Set("ETag", `"`+hex.EncodeToString(rdr.Sum(nil))+`"`)
+	writeCustomerKeyHeader(w, key)
+	etag := rdr.Sum(nil)
+	if result.ETag != nil {
+		etag = result.ETag
+	}
+	w.Header().Set("ETag", `"`+hex.EncodeToString(etag)+`"`)
 	return nil
 }
 
-func (g *Server) copyObject(bucket, object string, meta map[string]string, w http.ResponseWriter, r *http.Request) (err error) {
-	accesskey, autherr := GetAccessKey(r)
-	if autherr != nil {
-		return autherr
-	}
-	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
-		return err
-	}
+func (g *Server) copyObject(principal, accesskey, bucket, object string, meta map[string]string, key *CustomerKey, w http.ResponseWriter, r *http.Request) (err error) {
 	source := meta["X-Amz-Copy-Source"]
 	logrus.Infof("[S3]COPY %s TO /%s/%s", source, bucket, object)
 	if len(object) > KeySizeLimit {
 		return ResourceError(ErrKeyTooLong, object)
 	}
-	parts := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
-	srcBucket := parts[0]
-	srcKey := strings.SplitN(parts[1], "?", 2)[0]
-
-	srcKey, err = url.QueryUnescape(srcKey)
+	srcBucket, srcKey, srcVersion, err := parseCopySource(source)
 	if err != nil {
 		return err
 	}
-	srcObj, err := g.storage.GetObject(accesskey, srcBucket, srcKey, nil)
+	directive := r.Header.Get("X-Amz-Metadata-Directive")
+	if directive != "" && directive != "COPY" && directive != "REPLACE" {
+		return ErrorInvalidArgument("x-amz-metadata-directive", directive, "Unknown metadata directive.")
+	}
+	owner, err := g.copySourceOwner(principal, srcBucket, r)
+	if err != nil {
+		return err
+	}
+	if !g.canCopyFrom(principal, owner, srcBucket, srcKey, srcVersion) {
+		return ErrAccessDenied
+	}
+	srcCustomerKey, err := copySourceCustomerKey(r.Header)
+	if err != nil {
+		return err
+	}
+	var srcObj *Object
+	if srcCustomerKey != nil {
+		srcObj, err = g.getObjectWithCustomerKey(owner, srcBucket, srcKey, srcVersion, srcCustomerKey, nil)
+	} else if srcVersion == "" {
+		srcObj, err = g.storage.GetObject(owner, srcBucket, srcKey, nil)
+	} else if g.versioned != nil {
+		srcObj, err = g.versioned.GetObjectVersion(owner, srcBucket, srcKey, srcVersion, nil)
+	} else {
+		return ErrNotImplemented
+	}
 	if err != nil {
 		return err
 	}
@@ -506,9 +712,19 @@ func (g *Server) copyObject(bucket, object string, meta map[string]string, w htt
 		return ErrInternal
 	}
 	defer srcObj.Contents.Close()
-	for k, v := range srcObj.Metadata {
-		if _, found := meta[k]; !found && k != "X-Amz-Acl" {
-			meta[k] = v
+	if err := CheckCustomerKey(srcObj.Metadata, srcCustomerKey); err != nil {
+		return err
+	}
+	if directive != "REPLACE" {
+		for k := range meta {
+			if IsObjectMetadata(k) {
+				delete(meta, k)
+			}
+		}
+		for k, v := range srcObj.Metadata {
+			if IsObjectMetadata(k) {
+				meta[k] = v
+			}
 		}
 	}
 	result, err := g.storage.PutObject(accesskey, bucket, object, meta, srcObj.Contents, srcObj.Size)
@@ -522,22 +738,33 @@ func (g *Server) copyObject(bucket, object string, meta map[string]string, w htt
 		logrus.Infof("[S3]CREATED VERSION:%s/%s/%s", bucket, object, result.VersionID)
 		w.Header().Set("x-amz-version-id", string(result.VersionID))
 	}
+	writeCustomerKeyHeader(w, key)
+	etag := srcObj.Hash
+	if result.ETag != nil {
+		etag = result.ETag
+	}
 	return g.xmlEncoder(w).Encode(CopyObjectResult{
-		ETag:         `"` + hex.EncodeToString(srcObj.Hash) + `"`,
+		ETag:         `"` + hex.EncodeToString(etag) + `"`,
 		LastModified: NewContentTime(g.timeSource.Now()),
 	})
 }
 
 func (g *Server) deleteObject(bucket, object string, w http.ResponseWriter, r *http.Request) error {
 	logrus.Infof("[S3]DELETED:/%s/%s", bucket, object)
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, object, ActionDeleteObject)
 	if autherr != nil {
 		return autherr
 	}
 	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
 		return err
 	}
-	result, err := g.storage.DeleteObject(accesskey, bucket, object)
+	var result ObjectDeleteResult
+	var err error
+	if g.bypassGovernance(bucket, object, r) {
+		result, err = g.objectLock.DeleteObjectBypass(accesskey, bucket, object, "")
+	} else {
+		result, err = g.storage.DeleteObject(accesskey, bucket, object)
+	}
 	if err != nil {
 		return err
 	}
@@ -558,14 +785,20 @@ func (g *Server) deleteObjectVersion(bucket, object string, version VersionID, w
 		return ErrNotImplemented
 	}
 	logrus.Infof("[S3]DELETED VERSION:/%s/%s/%s", bucket, object, version)
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, object, ActionDeleteObject)
 	if autherr != nil {
 		return autherr
 	}
 	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
 		return err
 	}
-	result, err := g.versioned.DeleteObjectVersion(accesskey, bucket, object, version)
+	var result ObjectDeleteResult
+	var err error
+	if g.bypassGovernance(bucket, object, r) {
+		result, err = g.objectLock.DeleteObjectBypass(accesskey, bucket, object, version)
+	} else {
+		result, err = g.versioned.DeleteObjectVersion(accesskey, bucket, object, version)
+	}
 	if err != nil {
 		return err
 	}
@@ -585,7 +818,7 @@ func (g *Server) deleteObjectVersion(bucket, object string, version VersionID, w
 
 func (g *Server) deleteMulti(bucket string, w http.ResponseWriter, r *http.Request) error {
 	logrus.Infof("[S3]Delete multi:%s", bucket)
-	accesskey, autherr := GetAccessKey(r)
+	principal, accesskey, autherr := g.resolveOwner(r, bucket)
 	if autherr != nil {
 		return autherr
 	}
@@ -598,14 +831,20 @@ func (g *Server) deleteMulti(bucket string, w http.ResponseWriter, r *http.Reque
 	if err := dc.Decode(&in); err != nil {
 		return ErrorMessage(ErrMalformedXML, err.Error())
 	}
-	keys := make([]string, len(in.Objects))
-	for i, o := range in.Objects {
-		keys[i] = o.Key
+	keys := make([]string, 0, len(in.Objects))
+	denied := []ErrorResult{}
+	for _, o := range in.Objects {
+		if principal != accesskey && !g.allowed(principal, accesskey, bucket, o.Key, VersionID(o.VersionID), ActionDeleteObject) {
+			denied = append(denied, ErrorResult{Key: o.Key, Code: ErrAccessDenied, Message: ErrAccessDenied.Message()})
+			continue
+		}
+		keys = append(keys, o.Key)
 	}
 	out, err := g.storage.DeleteMulti(accesskey, bucket, keys...)
 	if err != nil {
 		return err
 	}
+	out.Error = append(out.Error, denied...)
 	if in.Quiet {
 		out.Deleted = nil
 	}
@@ -618,14 +857,27 @@ func (g *Server) initiateMultipartUpload(bucket, object string, w http.ResponseW
 	if err != nil {
 		return err
 	}
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, object, ActionPutObject)
 	if autherr != nil {
 		return autherr
 	}
 	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
 		return err
 	}
-	upload := g.uploader.Begin(bucket, object, meta, g.timeSource.Now())
+	key, err := customerKeyFromHeader(r.Header)
+	if err != nil {
+		return err
+	}
+	if key != nil && g.encryption == nil {
+		return ErrNotImplemented
+	}
+	setCustomerKey(meta, key)
+	if err := g.checkObjectLockHeaders(accesskey, bucket, meta); err != nil {
+		return err
+	}
+	upload := g.uploader.Begin(accesskey, bucket, object, meta, g.timeSource.Now())
+	upload.usePartCustomerKey(key)
+	writeCustomerKeyHeader(w, key)
 	out := InitiateMultipartUpload{
 		UploadID: upload.ID,
 		Bucket:   bucket,
@@ -636,18 +888,38 @@ func (g *Server) initiateMultipartUpload(bucket, object string, w http.ResponseW
 
 func (g *Server) putMultipartUploadPart(bucket, object string, uploadID UploadID, w http.ResponseWriter, r *http.Request) error {
 	logrus.Infof("[S3]Put multipart upload:/%s/%s/%s", bucket, object, uploadID)
+	principal, owner, autherr := g.authorizeAs(r, bucket, object, ActionPutObject)
+	if autherr != nil {
+		return autherr
+	}
 	partNumber, err := strconv.ParseInt(r.URL.Query().Get("partNumber"), 10, 0)
 	if err != nil || partNumber <= 0 || partNumber > MaxUploadPartNumber {
 		return ErrInvalidPart
 	}
+	if r.Header.Get("X-Amz-Copy-Source") != "" {
+		return g.copyMultipartUploadPart(principal, owner, bucket, object, uploadID, int(partNumber), w, r)
+	}
 	size, err := strconv.ParseInt(r.Header.Get("Content-Length"), 10, 64)
 	if err != nil || size <= 0 {
 		return ErrMissingContentLength
 	}
-	upload, err := g.uploader.Get(bucket, object, uploadID)
+	if r.Header.Get("X-Amz-Content-Sha256") == streamingPayload {
+		size, err = strconv.ParseInt(r.Header.Get("X-Amz-Decoded-Content-Length"), 10, 64)
+		if err != nil || size < 0 {
+			return ErrMissingContentLength
+		}
+	}
+	upload, err := g.uploader.Get(owner, bucket, object, uploadID)
+	if err != nil {
+		return err
+	}
+	key, err := customerKeyFromHeader(r.Header)
 	if err != nil {
 		return err
 	}
+	if err := upload.usePartCustomerKey(key); err != nil {
+		return err
+	}
 	defer r.Body.Close()
 	var rdr io.Reader = r.Body
 
@@ -664,26 +936,140 @@ func (g *Server) putMultipartUploadPart(bucket, object string, uploadID UploadID
 			}
 		}
 	}
-	etag, err := upload.AddPart(int(partNumber), g.timeSource.Now(), rdr, r.ContentLength)
+	etag, err := upload.AddPart(int(partNumber), g.timeSource.Now(), rdr, size)
 	if err != nil {
 		return err
 	}
+	writeCustomerKeyHeader(w, key)
 	w.Header().Add("ETag", etag)
 	return nil
 }
 
+func (g *Server) copyMultipartUploadPart(accesskey, destOwner, bucket, object string, uploadID UploadID, partNumber int, w http.ResponseWriter, r *http.Request) error {
+	source := r.Header.Get("X-Amz-Copy-Source")
+	logrus.Infof("[S3]Copy part %s TO /%s/%s/%s/%d", source, bucket, object, uploadID, partNumber)
+	upload, err := g.uploader.Get(destOwner, bucket, object, uploadID)
+	if err != nil {
+		return err
+	}
+	key, err := customerKeyFromHeader(r.Header)
+	if err != nil {
+		return err
+	}
+	if err := upload.usePartCustomerKey(key); err != nil {
+		return err
+	}
+	srcCustomerKey, err := copySourceCustomerKey(r.Header)
+	if err != nil {
+		return err
+	}
+	srcBucket, srcKey, srcVersion, err := parseCopySource(source)
+	if err != nil {
+		return err
+	}
+	owner, err := g.copySourceOwner(accesskey, srcBucket, r)
+	if err != nil {
+		return err
+	}
+	if !g.canCopyFrom(accesskey, owner, srcBucket, srcKey, srcVersion) {
+		return ErrAccessDenied
+	}
+	if err := g.ensureBucketExists(owner, srcBucket); err != nil {
+		return err
+	}
+	var rnge *ObjectRangeRequest
+	if s := r.Header.Get("X-Amz-Copy-Source-Range"); s != "" {
+		rnge, err = parseRangeHeader(s)
+		if err != nil {
+			return err
+		}
+		if rnge.FromEnd || rnge.End == RangeNoEnd {
+			return ErrorInvalidArgument("x-amz-copy-source-range", s, "The x-amz-copy-source-range value must be of the form bytes=first-last")
+		}
+	}
+	var obj *Object
+	if srcCustomerKey != nil {
+		obj, err = g.getObjectWithCustomerKey(owner, srcBucket, srcKey, srcVersion, srcCustomerKey, rnge)
+	} else if srcVersion == "" {
+		obj, err = g.storage.GetObject(owner, srcBucket, srcKey, rnge)
+	} else if g.versioned != nil {
+		obj, err = g.versioned.GetObjectVersion(owner, srcBucket, srcKey, srcVersion, rnge)
+	} else {
+		return ErrNotImplemented
+	}
+	if err != nil {
+		return err
+	}
+	if obj == nil || obj.Contents == nil {
+		logrus.Errorf("[S3]Unexpected nil object for key:/%s/%s", srcBucket, srcKey)
+		return ErrInternal
+	}
+	defer obj.Contents.Close()
+	if obj.IsDeleteMarker {
+		return KeyNotFound(srcKey)
+	}
+	if err := CheckCustomerKey(obj.Metadata, srcCustomerKey); err != nil {
+		return err
+	}
+	size := obj.Size
+	if obj.Range != nil {
+		size = obj.Range.Length
+	}
+	etag, err := upload.AddPart(partNumber, g.timeSource.Now(), obj.Contents, size)
+	if err != nil {
+		return err
+	}
+	if obj.VersionID != "" {
+		w.Header().Set("x-amz-copy-source-version-id", string(obj.VersionID))
+	}
+	writeCustomerKeyHeader(w, key)
+	return g.xmlEncoder(w).Encode(CopyPartResult{
+		ETag:         etag,
+		LastModified: NewContentTime(g.timeSource.Now()),
+	})
+}
+
+func parseCopySource(source string) (bucket, key string, version VersionID, err error) {
+	var query string
+	if idx := strings.IndexByte(source, '?'); idx >= 0 {
+		source, query = source[:idx], source[idx+1:]
+	}
+	parts := strings.SplitN(strings.TrimPrefix(source, "/"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", ErrorInvalidArgument("x-amz-copy-source", source, "Copy Source must mention the source bucket and key: sourcebucket/sourcekey")
+	}
+	bucket = parts[0]
+	if key, err = url.QueryUnescape(parts[1]); err != nil {
+		return "", "", "", ErrorInvalidArgument("x-amz-copy-source", source, err.Error())
+	}
+	if query != "" {
+		q, err := url.ParseQuery(query)
+		if err != nil {
+			return "", "", "", ErrorInvalidArgument("x-amz-copy-source", source, err.Error())
+		}
+		version = VersionID(versionFromQuery(q["versionId"]))
+	}
+	return bucket, key, version, nil
+}
+
 func (g *Server) abortMultipartUpload(bucket, object string, uploadID UploadID, w http.ResponseWriter, r *http.Request) error {
 	logrus.Infof("[S3]Abort multipart upload:/%s/%s/%s", bucket, object, uploadID)
-	if _, err := g.uploader.Complete(bucket, object, uploadID); err != nil {
+	accesskey, autherr := g.authorize(r, bucket, object, ActionAbortMultipartUpload)
+	if autherr != nil {
+		return autherr
+	}
+	upload, err := g.uploader.Complete(accesskey, bucket, object, uploadID)
+	if err != nil {
 		return err
 	}
+	upload.RemoveFiles()
 	w.WriteHeader(http.StatusNoContent)
 	return nil
 }
 
 func (g *Server) completeMultipartUpload(bucket, object string, uploadID UploadID, w http.ResponseWriter, r *http.Request) error {
 	logrus.Infof("[S3]Complete multipart upload:/%s/%s/%s", bucket, object, uploadID)
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, object, ActionPutObject)
 	if autherr != nil {
 		return autherr
 	}
@@ -691,7 +1077,22 @@ func (g *Server) completeMultipartUpload(bucket, object string, uploadID UploadI
 	if err := g.xmlDecodeBody(r.Body, &in); err != nil {
 		return err
 	}
-	upload, err := g.uploader.Complete(bucket, object, uploadID)
+	defer lockConditionalWrite(r, bucket, object)()
+	if err := g.checkWriteConditions(accesskey, bucket, object, r); err != nil {
+		return err
+	}
+	upload, err := g.uploader.Get(accesskey, bucket, object, uploadID)
+	if err != nil {
+		return err
+	}
+	key, err := customerKeyFromHeader(r.Header)
+	if err != nil {
+		return err
+	}
+	if key, err = upload.completionCustomerKey(key); err != nil {
+		return err
+	}
+	upload, err = g.uploader.Complete(accesskey, bucket, object, uploadID)
 	if err != nil {
 		return err
 	}
@@ -699,7 +1100,13 @@ func (g *Server) completeMultipartUpload(bucket, object string, uploadID UploadI
 	if err != nil {
 		return err
 	}
-	result, md5, err := g.storage.MultipartUpload(accesskey, bucket, object, files, upload.Meta)
+	var result PutObjectResult
+	var md5 []byte
+	if key != nil {
+		result, md5, err = g.encryption.MultipartUploadWithCustomerKey(accesskey, bucket, object, files, upload.Meta, key)
+	} else {
+		result, md5, err = g.storage.MultipartUpload(accesskey, bucket, object, files, upload.Meta)
+	}
 	if err != nil {
 		return err
 	}
@@ -707,6 +1114,7 @@ func (g *Server) completeMultipartUpload(bucket, object string, uploadID UploadI
 	if result.VersionID != "" {
 		w.Header().Set("x-amz-version-id", string(result.VersionID))
 	}
+	writeCustomerKeyHeader(w, key)
 	return g.xmlEncoder(w).Encode(&CompleteMultipartUploadResult{
 		ETag:   etag,
 		Bucket: bucket,
@@ -715,7 +1123,7 @@ func (g *Server) completeMultipartUpload(bucket, object string, uploadID UploadI
 }
 
 func (g *Server) listMultipartUploads(bucket string, w http.ResponseWriter, r *http.Request) error {
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, "", ActionListBucketMultipartUploads)
 	if autherr != nil {
 		return autherr
 	}
@@ -732,7 +1140,7 @@ func (g *Server) listMultipartUploads(bucket string, w http.ResponseWriter, r *h
 	if maxUploads == 0 {
 		maxUploads = DefaultMaxUploads
 	}
-	out, err := g.uploader.List(bucket, marker, prefix, maxUploads)
+	out, err := g.uploader.List(accesskey, bucket, marker, prefix, maxUploads)
 	if err != nil {
 		return err
 	}
@@ -740,7 +1148,7 @@ func (g *Server) listMultipartUploads(bucket string, w http.ResponseWriter, r *h
 }
 
 func (g *Server) listMultipartUploadParts(bucket, object string, uploadID UploadID, w http.ResponseWriter, r *http.Request) error {
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, object, ActionListMultipartUploadParts)
 	if autherr != nil {
 		return autherr
 	}
@@ -756,7 +1164,7 @@ func (g *Server) listMultipartUploadParts(bucket, object string, uploadID Upload
 	if err != nil {
 		return ErrInvalidURI
 	}
-	out, err := g.uploader.ListParts(bucket, object, uploadID, int(marker), maxParts)
+	out, err := g.uploader.ListParts(accesskey, bucket, object, uploadID, int(marker), maxParts)
 	if err != nil {
 		return err
 	}
@@ -764,7 +1172,7 @@ func (g *Server) listMultipartUploadParts(bucket, object string, uploadID Upload
 }
 
 func (g *Server) getBucketVersioning(bucket string, w http.ResponseWriter, r *http.Request) error {
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
 	if autherr != nil {
 		return autherr
 	}
@@ -783,7 +1191,7 @@ func (g *Server) getBucketVersioning(bucket string, w http.ResponseWriter, r *ht
 }
 
 func (g *Server) putBucketVersioning(bucket string, w http.ResponseWriter, r *http.Request) error {
-	accesskey, autherr := GetAccessKey(r)
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
 	if autherr != nil {
 		return autherr
 	}
@@ -854,13 +1262,35 @@ func metadataSize(meta map[string]string) int {
 	return total
 }
 
+var objectHeaders = map[string]bool{
+	"Content-Type":        true,
+	"Content-Disposition": true,
+	"Content-Encoding":    true,
+	"Content-Language":    true,
+	"Cache-Control":       true,
+	"Expires":             true,
+}
+
+// IsObjectMetadata reports whether a metadata key belongs to the object
+// itself, as opposed to a request header, and should be stored with it.
+func IsObjectMetadata(key string) bool {
+	return objectHeaders[key] || strings.HasPrefix(key, "X-Amz-Meta-")
+}
+
 func metadataHeaders(headers map[string][]string, at time.Time, sizeLimit int) (map[string]string, error) {
 	meta := make(map[string]string)
 	for hk, hv := range headers {
-		if strings.HasPrefix(hk, "X-Amz-") || hk == "Content-Type" || hk == "Content-Disposition" {
+		if strings.HasPrefix(hk, "X-Amz-") || objectHeaders[hk] {
 			meta[hk] = hv[0]
 		}
 	}
+	if enc, ok := meta["Content-Encoding"]; ok {
+		if enc = strings.Trim(strings.Replace(enc, "aws-chunked", "", 1), ", "); enc != "" {
+			meta["Content-Encoding"] = enc
+		} else {
+			delete(meta, "Content-Encoding")
+		}
+	}
 	meta["Last-Modified"] = formatHeaderTime(at)
 	if sizeLimit > 0 && metadataSize(meta) > sizeLimit {
 		return meta, ErrMetadataTooLarge
@@ -0,0 +1,375 @@
+package s3
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	signV4Algorithm     = "AWS4-HMAC-SHA256"
+	signV4ChunkAlgo     = "AWS4-HMAC-SHA256-PAYLOAD"
+	iso8601Format       = "20060102T150405Z"
+	yyyymmdd            = "20060102"
+	unsignedPayload     = "UNSIGNED-PAYLOAD"
+	streamingPayload    = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	emptySHA256         = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	accessKeyPrefix     = "YTA"
+	signV4Service       = "s3"
+	signV4RequestSuffix = "aws4_request"
+)
+
+var MaxRequestSkew = 15 * time.Minute
+
+type AuthBackend interface {
+	// SecretKey returns the secret access key of accesskey, which should be
+	// made with DeriveSecretKey rather than be the user's private key.
+	SecretKey(accesskey string) (string, error)
+}
+
+// secretKeyContext keeps the derived secret apart from any other value
+// computed from the same private key.
+const secretKeyContext = "yotta-s3-sigv4"
+
+// DeriveSecretKey returns the SigV4 secret access key for a private key.
+// S3 clients hold this secret, never the private key itself, so leaking an
+// S3 configuration does not allow signing chain transactions.
+func DeriveSecretKey(privateKey string) string {
+	mac := hmac.New(sha256.New, []byte(privateKey))
+	mac.Write([]byte(secretKeyContext))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+type credentialScope struct {
+	accessKey string
+	date      string
+	region    string
+	service   string
+	request   string
+}
+
+func (c credentialScope) String() string {
+	return strings.Join([]string{c.date, c.region, c.service, c.request}, "/")
+}
+
+type signV4Values struct {
+	credential    credentialScope
+	signedHeaders []string
+	signature     string
+}
+
+func parseCredential(s string) (credentialScope, error) {
+	var c credentialScope
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if len(parts) != 5 {
+		return c, ErrCredMalformed
+	}
+	c.accessKey, c.date, c.region, c.service, c.request = parts[0], parts[1], parts[2], parts[3], parts[4]
+	if _, err := time.Parse(yyyymmdd, c.date); err != nil {
+		return c, ErrCredMalformed
+	}
+	if c.service != signV4Service {
+		return c, ErrInvalidService
+	}
+	if c.request != signV4RequestSuffix {
+		return c, ErrInvalidRequestVersion
+	}
+	if !strings.HasPrefix(c.accessKey, accessKeyPrefix) || len(c.accessKey) == len(accessKeyPrefix) {
+		return c, ErrInvalidAccessKeyID
+	}
+	return c, nil
+}
+
+func parseSignedHeaders(s string) ([]string, error) {
+	if s == "" {
+		return nil, ErrMissingSignHeadersTag
+	}
+	headers := strings.Split(s, ";")
+	if !sort.StringsAreSorted(headers) {
+		return nil, ErrSignedHeadersNotSorted
+	}
+	return headers, nil
+}
+
+func parseSignV4(auth string) (*signV4Values, error) {
+	if !strings.HasPrefix(auth, signV4Algorithm) {
+		return nil, ErrSignatureVersionNotSupported
+	}
+	fields := strings.Split(strings.TrimSpace(strings.TrimPrefix(auth, signV4Algorithm)), ",")
+	if len(fields) != 3 {
+		return nil, ErrMissingFields
+	}
+	kv := make(map[string]string)
+	for _, f := range fields {
+		pair := strings.SplitN(strings.TrimSpace(f), "=", 2)
+		if len(pair) != 2 {
+			return nil, ErrMissingFields
+		}
+		kv[pair[0]] = pair[1]
+	}
+	v := &signV4Values{}
+	cred, ok := kv["Credential"]
+	if !ok {
+		return nil, ErrMissingCredTag
+	}
+	var err error
+	if v.credential, err = parseCredential(cred); err != nil {
+		return nil, err
+	}
+	if v.signedHeaders, err = parseSignedHeaders(kv["SignedHeaders"]); err != nil {
+		return nil, err
+	}
+	if v.signature = kv["Signature"]; v.signature == "" {
+		return nil, ErrMissingSignTag
+	}
+	return v, nil
+}
+
+func requestTime(r *http.Request) (time.Time, string, error) {
+	if s := r.Header.Get("X-Amz-Date"); s != "" {
+		t, err := time.Parse(iso8601Format, s)
+		if err != nil {
+			return t, "", ErrMalformedDate
+		}
+		return t, s, nil
+	}
+	if s := r.Header.Get("Date"); s != "" {
+		t, err := http.ParseTime(s)
+		if err != nil {
+			return t, "", ErrMalformedDate
+		}
+		return t, t.UTC().Format(iso8601Format), nil
+	}
+	return time.Time{}, "", ErrMissingDateHeader
+}
+
+func (g *Server) checkSkew(t time.Time) error {
+	now := g.timeSource.Now()
+	skew := now.Sub(t)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > MaxRequestSkew {
+		return RequestTimeTooSkewed(now, MaxRequestSkew)
+	}
+	return nil
+}
+
+func (g *Server) authenticate(r *http.Request) (string, error) {
+	if g.auth == nil {
+		accesskey, err := GetAccessKey(r)
+		if err == nil && r.Header.Get("X-Amz-Content-Sha256") == streamingPayload {
+			r.Body = newChunkedReader(r.Body, nil)
+		}
+		return accesskey, err
+	}
+	auth := r.Header.Get("Authorization")
+	if auth == "" {
+		if isPresigned(r) {
+			return g.authenticatePresigned(r)
+		}
+		return "", ErrAccessDenied
+	}
+	sv, err := parseSignV4(auth)
+	if err != nil {
+		return "", err
+	}
+	t, amzdate, err := requestTime(r)
+	if err != nil {
+		return "", err
+	}
+	if err := g.checkSkew(t); err != nil {
+		return "", err
+	}
+	if t.UTC().Format(yyyymmdd) != sv.credential.date {
+		return "", ErrorMessage(ErrAuthorizationHeaderMalformed, "credential date does not match request date")
+	}
+	for _, h := range []string{"host", "x-amz-content-sha256"} {
+		if !containsString(sv.signedHeaders, h) && (h == "host" || r.Header.Get(h) != "") {
+			return "", ErrMissingRequiredSignedHeader
+		}
+	}
+	accesskey := strings.TrimPrefix(sv.credential.accessKey, accessKeyPrefix)
+	secret, err := g.auth.SecretKey(accesskey)
+	if err != nil {
+		return "", err
+	}
+	payload := r.Header.Get("X-Amz-Content-Sha256")
+	if payload == "" {
+		payload = unsignedPayload
+	}
+	canonical := canonicalRequest(r, r.URL.Query(), sv.signedHeaders, payload)
+	key := signingKey(secret, sv.credential)
+	expected := signature(key, stringToSign(amzdate, sv.credential, canonical))
+	if !hmac.Equal([]byte(expected), []byte(sv.signature)) {
+		return "", ErrSignatureDoesNotMatch
+	}
+	switch payload {
+	case unsignedPayload:
+	case streamingPayload:
+		r.Body = newChunkedReader(r.Body, &chunkSigner{
+			key:     key,
+			amzdate: amzdate,
+			scope:   sv.credential.String(),
+			prevSig: sv.signature,
+		})
+	default:
+		sum, err := hex.DecodeString(payload)
+		if err != nil || len(sum) != sha256.Size {
+			return "", ErrContentSHA256Mismatch
+		}
+		r.Body = newSHA256Reader(r.Body, sum)
+	}
+	return accesskey, nil
+}
+
+func canonicalRequest(r *http.Request, query url.Values, signedHeaders []string, payload string) string {
+	return strings.Join([]string{
+		r.Method,
+		uriEncode(r.URL.Path, false),
+		canonicalQuery(query),
+		canonicalHeaders(r, signedHeaders),
+		strings.Join(signedHeaders, ";"),
+		payload,
+	}, "\n")
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		if k == "X-Amz-Signature" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	for _, k := range keys {
+		vals := append([]string{}, query[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			if buf.Len() > 0 {
+				buf.WriteByte('&')
+			}
+			buf.WriteString(uriEncode(k, true))
+			buf.WriteByte('=')
+			buf.WriteString(uriEncode(v, true))
+		}
+	}
+	return buf.String()
+}
+
+func canonicalHeaders(r *http.Request, signedHeaders []string) string {
+	var buf bytes.Buffer
+	for _, h := range signedHeaders {
+		buf.WriteString(h)
+		buf.WriteByte(':')
+		switch h {
+		case "host":
+			buf.WriteString(r.Host)
+		case "content-length":
+			if vals := r.Header.Values(h); len(vals) > 0 {
+				buf.WriteString(trimHeaderValue(vals[0]))
+			} else {
+				buf.WriteString(strconv.FormatInt(r.ContentLength, 10))
+			}
+		default:
+			vals := r.Header.Values(h)
+			for i, v := range vals {
+				if i > 0 {
+					buf.WriteByte(',')
+				}
+				buf.WriteString(trimHeaderValue(v))
+			}
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.String()
+}
+
+func trimHeaderValue(v string) string {
+	return strings.Join(strings.Fields(v), " ")
+}
+
+func stringToSign(amzdate string, scope credentialScope, canonical string) string {
+	sum := sha256.Sum256([]byte(canonical))
+	return strings.Join([]string{
+		signV4Algorithm,
+		amzdate,
+		scope.String(),
+		hex.EncodeToString(sum[:]),
+	}, "\n")
+}
+
+func signingKey(secret string, scope credentialScope) []byte {
+	date := hmacSHA256([]byte("AWS4"+secret), []byte(scope.date))
+	region := hmacSHA256(date, []byte(scope.region))
+	service := hmacSHA256(region, []byte(scope.service))
+	return hmacSHA256(service, []byte(scope.request))
+}
+
+func signature(key []byte, stringToSign string) string {
+	return hex.EncodeToString(hmacSHA256(key, []byte(stringToSign)))
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func uriEncode(s string, encodeSlash bool) string {
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			buf.WriteByte(c)
+		} else {
+			buf.WriteString("%")
+			buf.WriteString(strings.ToUpper(hex.EncodeToString([]byte{c})))
+		}
+	}
+	return buf.String()
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+type chunkSigner struct {
+	key     []byte
+	amzdate string
+	scope   string
+	prevSig string
+}
+
+func (s *chunkSigner) verify(sig string, data []byte) error {
+	sum := sha256.Sum256(data)
+	sts := strings.Join([]string{
+		signV4ChunkAlgo,
+		s.amzdate,
+		s.scope,
+		s.prevSig,
+		emptySHA256,
+		hex.EncodeToString(sum[:]),
+	}, "\n")
+	expected := signature(s.key, sts)
+	if !hmac.Equal([]byte(expected), []byte(sig)) {
+		return ErrSignatureDoesNotMatch
+	}
+	s.prevSig = sig
+	return nil
+}
@@ -0,0 +1,114 @@
+package s3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The request and keys are the GET Object example of the AWS Signature
+// Version 4 documentation; the access key is outside the string to sign.
+const (
+	testSecretKey = "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"
+	testSignature = "f0e8bdb87c964420e857bd35b5d6ed310bd44f0170aba48dd91039c6036bdb41"
+)
+
+var testSignTime = time.Date(2013, 5, 24, 0, 0, 0, 0, time.UTC)
+
+type testAuthBackend map[string]string
+
+func (b testAuthBackend) SecretKey(accesskey string) (string, error) {
+	if secret, ok := b[accesskey]; ok {
+		return secret, nil
+	}
+	return "", ErrInvalidAccessKeyID
+}
+
+func testAuthServer(now time.Time) *Server {
+	return &Server{auth: testAuthBackend{"example": testSecretKey}, timeSource: FixedTimeSource(now)}
+}
+
+func testSignedRequest() *http.Request {
+	r := httptest.NewRequest("GET", "http://examplebucket.s3.amazonaws.com/test.txt", nil)
+	r.Header.Set("Range", "bytes=0-9")
+	r.Header.Set("X-Amz-Content-Sha256", emptySHA256)
+	r.Header.Set("X-Amz-Date", "20130524T000000Z")
+	r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=YTAexample/20130524/us-east-1/s3/aws4_request,"+
+		"SignedHeaders=host;range;x-amz-content-sha256;x-amz-date,Signature="+testSignature)
+	return r
+}
+
+func TestAuthenticate(t *testing.T) {
+	setAuth := func(old, new string) func(*http.Request) {
+		return func(r *http.Request) {
+			r.Header.Set("Authorization", strings.Replace(r.Header.Get("Authorization"), old, new, 1))
+		}
+	}
+	tests := []struct {
+		name   string
+		now    time.Time
+		change func(*http.Request)
+		code   ErrorCode
+	}{
+		{"valid", testSignTime, nil, ""},
+		{"within skew", testSignTime.Add(10 * time.Minute), nil, ""},
+		{"skewed", testSignTime.Add(time.Hour), nil, ErrRequestTimeTooSkewed},
+		{"skewed ahead", testSignTime.Add(-time.Hour), nil, ErrRequestTimeTooSkewed},
+		{"signature", testSignTime, setAuth("Signature=f0", "Signature=f1"), ErrSignatureDoesNotMatch},
+		{"signed header", testSignTime, func(r *http.Request) { r.Header.Set("Range", "bytes=0-99") }, ErrSignatureDoesNotMatch},
+		{"path", testSignTime, func(r *http.Request) { r.URL.Path = "/test2.txt" }, ErrSignatureDoesNotMatch},
+		{"query", testSignTime, func(r *http.Request) { r.URL.RawQuery = "acl" }, ErrSignatureDoesNotMatch},
+		{"method", testSignTime, func(r *http.Request) { r.Method = "DELETE" }, ErrSignatureDoesNotMatch},
+		{"host", testSignTime, func(r *http.Request) { r.Host = "otherbucket.s3.amazonaws.com" }, ErrSignatureDoesNotMatch},
+		{"secret", testSignTime, setAuth("YTAexample", "YTAother"), ErrInvalidAccessKeyID},
+		{"key prefix", testSignTime, setAuth("YTAexample", "AKIAexample"), ErrInvalidAccessKeyID},
+		{"credential date", testSignTime, setAuth("/20130524/", "/20130525/"), ErrAuthorizationHeaderMalformed},
+		{"service", testSignTime, setAuth("/s3/", "/ec2/"), ErrInvalidService},
+		{"unsorted headers", testSignTime, setAuth("host;range", "range;host"), ErrSignedHeadersNotSorted},
+		{"host not signed", testSignTime, setAuth("host;range", "range"), ErrMissingRequiredSignedHeader},
+		{"missing signature", testSignTime, setAuth(",Signature="+testSignature, ",Signature="), ErrMissingSignTag},
+		{"algorithm", testSignTime, setAuth("AWS4-HMAC-SHA256", "AWS"), ErrSignatureVersionNotSupported},
+		{"date", testSignTime, func(r *http.Request) { r.Header.Set("X-Amz-Date", "yesterday") }, ErrMalformedDate},
+		{"no date", testSignTime, func(r *http.Request) { r.Header.Del("X-Amz-Date") }, ErrMissingDateHeader},
+		{"anonymous", testSignTime, func(r *http.Request) { r.Header.Del("Authorization") }, ErrAccessDenied},
+	}
+	for _, tt := range tests {
+		r := testSignedRequest()
+		if tt.change != nil {
+			tt.change(r)
+		}
+		accesskey, err := testAuthServer(tt.now).authenticate(r)
+		if !HasErrorCode(err, tt.code) {
+			t.Errorf("%s: got %v, expected %q", tt.name, err, tt.code)
+			continue
+		}
+		if err == nil && accesskey != "example" {
+			t.Errorf("%s: access key %q", tt.name, accesskey)
+		}
+	}
+}
+
+func TestAuthenticatePayloadHash(t *testing.T) {
+	r := testSignedRequest()
+	r.Body = ioutil.NopCloser(strings.NewReader("not empty"))
+	if _, err := testAuthServer(testSignTime).authenticate(r); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ioutil.ReadAll(r.Body); err != ErrContentSHA256Mismatch {
+		t.Errorf("body read: %v", err)
+	}
+}
+
+func TestDeriveSecretKey(t *testing.T) {
+	privateKey := "5JTestPrivateKeyWIF"
+	secret := DeriveSecretKey(privateKey)
+	if secret != "eb0a885f57ddee8fa71df567764b2b86205acfad1824650fd71caf30601c4ccb" {
+		t.Errorf("secret %s", secret)
+	}
+	if DeriveSecretKey(privateKey+"x") == secret {
+		t.Error("different keys derive the same secret")
+	}
+}
@@ -0,0 +1,150 @@
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"net/http"
+)
+
+const (
+	SSECustomerAlgorithm = "X-Amz-Server-Side-Encryption-Customer-Algorithm"
+	SSECustomerKey       = "X-Amz-Server-Side-Encryption-Customer-Key"
+	SSECustomerKeyMD5    = "X-Amz-Server-Side-Encryption-Customer-Key-Md5"
+
+	copySourceSSEPrefix = "X-Amz-Copy-Source-Server-Side-Encryption-Customer-"
+)
+
+// CustomerKey is an SSE-C key sent with the request. It is never stored;
+// objects keep only KeyMD5.
+type CustomerKey struct {
+	Key    []byte
+	KeyMD5 string
+}
+
+type EncryptionBackend interface {
+	GetObjectWithCustomerKey(accesskey string,
+		bucketName, objectName string,
+		versionID VersionID,
+		key *CustomerKey,
+		rangeRequest *ObjectRangeRequest) (*Object, error)
+
+	// MultipartUploadWithCustomerKey is MultipartUpload for an upload
+	// initiated under a customer key. The ETag returned is keyed by it.
+	MultipartUploadWithCustomerKey(accesskey, bucketName, objectName string,
+		partsPath []string,
+		meta map[string]string,
+		key *CustomerKey) (PutObjectResult, []byte, error)
+}
+
+// CustomerKeyFromMeta parses the SSE-C headers kept in the metadata passed to
+// PutObject. It returns nil if the request carries no customer key.
+func CustomerKeyFromMeta(meta map[string]string) (*CustomerKey, error) {
+	return parseCustomerKey(meta[SSECustomerAlgorithm], meta[SSECustomerKey], meta[SSECustomerKeyMD5])
+}
+
+func customerKeyFromHeader(h http.Header) (*CustomerKey, error) {
+	return parseCustomerKey(h.Get(SSECustomerAlgorithm), h.Get(SSECustomerKey), h.Get(SSECustomerKeyMD5))
+}
+
+func copySourceCustomerKey(h http.Header) (*CustomerKey, error) {
+	return parseCustomerKey(h.Get(copySourceSSEPrefix+"Algorithm"), h.Get(copySourceSSEPrefix+"Key"), h.Get(copySourceSSEPrefix+"Key-Md5"))
+}
+
+func parseCustomerKey(algorithm, key, keyMD5 string) (*CustomerKey, error) {
+	if algorithm == "" && key == "" && keyMD5 == "" {
+		return nil, nil
+	}
+	if algorithm != "AES256" {
+		return nil, ErrorInvalidArgument(SSECustomerAlgorithm, algorithm, "The requested encryption algorithm is not valid")
+	}
+	bs, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(bs) != 32 {
+		return nil, ErrorInvalidArgument(SSECustomerKey, "", "The secret key was invalid for the specified algorithm")
+	}
+	sum := md5.Sum(bs)
+	ck := &CustomerKey{Key: bs, KeyMD5: base64.StdEncoding.EncodeToString(sum[:])}
+	if keyMD5 != ck.KeyMD5 {
+		return nil, ErrorInvalidArgument(SSECustomerKeyMD5, keyMD5, "The calculated MD5 hash of the key did not match the hash that was provided")
+	}
+	return ck, nil
+}
+
+// CheckCustomerKey matches the key of a request against the one the object
+// was stored with.
+func CheckCustomerKey(meta map[string]string, key *CustomerKey) error {
+	stored := meta[SSECustomerKeyMD5]
+	if stored == "" {
+		if key != nil {
+			return ErrorMessage(ErrInvalidRequest, "The encryption parameters are not applicable to this object")
+		}
+		return nil
+	}
+	if key == nil || key.KeyMD5 != stored {
+		return CustomerKeyError(key)
+	}
+	return nil
+}
+
+// CustomerKeyError is the error for an object stored under a customer key
+// that is requested without it, or with another key.
+func CustomerKeyError(key *CustomerKey) error {
+	if key == nil {
+		return ErrorMessage(ErrInvalidRequest, "The object was stored using a form of Server Side Encryption. The correct parameters must be provided to retrieve the object")
+	}
+	return ErrAccessDenied
+}
+
+func (g *Server) getObjectWithCustomerKey(accesskey, bucket, object string, versionID VersionID, key *CustomerKey, rnge *ObjectRangeRequest) (*Object, error) {
+	if g.encryption == nil {
+		return nil, ErrNotImplemented
+	}
+	return g.encryption.GetObjectWithCustomerKey(accesskey, bucket, object, versionID, key, rnge)
+}
+
+// setCustomerKey replaces the key sent with the request by the metadata kept
+// with an upload initiated under a customer key.
+func setCustomerKey(meta map[string]string, key *CustomerKey) {
+	delete(meta, SSECustomerKey)
+	if key != nil {
+		meta[SSECustomerAlgorithm] = "AES256"
+		meta[SSECustomerKeyMD5] = key.KeyMD5
+	}
+}
+
+// usePartCustomerKey checks the customer key sent with a part against the
+// one the upload was initiated with. The key is kept for completing the
+// upload in memory only, it is never saved with the upload record.
+func (mpu *multipartUpload) usePartCustomerKey(key *CustomerKey) error {
+	if err := CheckCustomerKey(mpu.Meta, key); err != nil {
+		return err
+	}
+	if key != nil {
+		mpu.mu.Lock()
+		mpu.customerKey = key
+		mpu.mu.Unlock()
+	}
+	return nil
+}
+
+// completionCustomerKey returns the customer key an upload is completed
+// with: the one sent with the request or else the one its parts were sent
+// with. Once the gateway restarts, the key must be sent again with a part
+// or the completion.
+func (mpu *multipartUpload) completionCustomerKey(key *CustomerKey) (*CustomerKey, error) {
+	if key == nil {
+		mpu.mu.Lock()
+		key = mpu.customerKey
+		mpu.mu.Unlock()
+	}
+	if err := CheckCustomerKey(mpu.Meta, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func writeCustomerKeyHeader(w http.ResponseWriter, key *CustomerKey) {
+	if key != nil {
+		w.Header().Set(SSECustomerAlgorithm, "AES256")
+		w.Header().Set(SSECustomerKeyMD5, key.KeyMD5)
+	}
+}
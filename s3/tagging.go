@@ -0,0 +1,131 @@
+package s3
+
+import (
+	"encoding/xml"
+	"net/http"
+	"sort"
+	"unicode/utf8"
+)
+
+const (
+	MaxObjectTags     = 10
+	MaxTagKeyLength   = 128
+	MaxTagValueLength = 256
+)
+
+type TaggingBackend interface {
+	GetObjectTagging(accesskey string, bucketName, objectName string, versionID VersionID) (map[string]string, error)
+
+	PutObjectTagging(accesskey string, bucketName, objectName string, versionID VersionID, tags map[string]string) error
+
+	DeleteObjectTagging(accesskey string, bucketName, objectName string, versionID VersionID) error
+}
+
+type Tagging struct {
+	XMLName xml.Name `xml:"Tagging"`
+	TagSet  []Tag    `xml:"TagSet>Tag"`
+}
+
+type Tag struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+func (t *Tagging) Map() (map[string]string, error) {
+	if len(t.TagSet) > MaxObjectTags {
+		return nil, ErrorMessagef(ErrInvalidTag, "Object tags cannot be greater than %d", MaxObjectTags)
+	}
+	tags := make(map[string]string, len(t.TagSet))
+	for _, tag := range t.TagSet {
+		if tag.Key == "" || utf8.RuneCountInString(tag.Key) > MaxTagKeyLength {
+			return nil, ErrorMessage(ErrInvalidTag, "The TagKey you have provided is invalid")
+		}
+		if utf8.RuneCountInString(tag.Value) > MaxTagValueLength {
+			return nil, ErrorMessage(ErrInvalidTag, "The TagValue you have provided is invalid")
+		}
+		if _, ok := tags[tag.Key]; ok {
+			return nil, ErrorMessage(ErrInvalidTag, "Cannot provide multiple Tags with the same key")
+		}
+		tags[tag.Key] = tag.Value
+	}
+	return tags, nil
+}
+
+func (g *Server) routeTagging(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getObjectTagging(bucket, object, versionID, w, r)
+	case "PUT":
+		return g.putObjectTagging(bucket, object, versionID, w, r)
+	case "DELETE":
+		return g.deleteObjectTagging(bucket, object, versionID, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getObjectTagging(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, object, ActionGetObjectTagging)
+	if autherr != nil {
+		return autherr
+	}
+	if g.tagging == nil {
+		return ErrNotImplemented
+	}
+	tags, err := g.tagging.GetObjectTagging(accesskey, bucket, object, versionID)
+	if err != nil {
+		return err
+	}
+	out := Tagging{TagSet: []Tag{}}
+	for k, v := range tags {
+		out.TagSet = append(out.TagSet, Tag{Key: k, Value: v})
+	}
+	sort.Slice(out.TagSet, func(i, j int) bool { return out.TagSet[i].Key < out.TagSet[j].Key })
+	if versionID != "" {
+		w.Header().Set("x-amz-version-id", string(versionID))
+	}
+	return g.xmlEncoder(w).Encode(out)
+}
+
+func (g *Server) putObjectTagging(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, object, ActionPutObjectTagging)
+	if autherr != nil {
+		return autherr
+	}
+	if g.tagging == nil {
+		return ErrNotImplemented
+	}
+	var in Tagging
+	if err := g.xmlDecodeBody(r.Body, &in); err != nil {
+		return err
+	}
+	tags, err := in.Map()
+	if err != nil {
+		return err
+	}
+	if err := g.tagging.PutObjectTagging(accesskey, bucket, object, versionID, tags); err != nil {
+		return err
+	}
+	if versionID != "" {
+		w.Header().Set("x-amz-version-id", string(versionID))
+	}
+	return nil
+}
+
+func (g *Server) deleteObjectTagging(bucket, object string, versionID VersionID, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, object, ActionDeleteObjectTagging)
+	if autherr != nil {
+		return autherr
+	}
+	if g.tagging == nil {
+		return ErrNotImplemented
+	}
+	if err := g.tagging.DeleteObjectTagging(accesskey, bucket, object, versionID); err != nil {
+		return err
+	}
+	if versionID != "" {
+		w.Header().Set("x-amz-version-id", string(versionID))
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
@@ -3,22 +3,24 @@ package s3
 import (
 	"crypto/md5"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
-	"math/big"
 	"net/url"
 	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/ryszard/goskiplist/skiplist"
 	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/api/cache"
 	"github.com/yottachain/YTCoreService/env"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-var add1 = new(big.Int).SetInt64(1)
-
 type bucketUploads struct {
 	uploads map[UploadID]*multipartUpload
 
@@ -76,43 +78,85 @@ func (bu *bucketUploads) remove(uploadID UploadID) {
 }
 
 type uploader struct {
-	uploadID *big.Int
-
 	buckets map[string]*bucketUploads
 	mu      sync.Mutex
 }
 
 func newUploader() *uploader {
-	return &uploader{
-		buckets:  make(map[string]*bucketUploads),
-		uploadID: new(big.Int),
+	u := &uploader{
+		buckets: make(map[string]*bucketUploads),
 	}
+	u.load()
+	return u
 }
 
-func (u *uploader) Begin(bucket, object string, meta map[string]string, initiated time.Time) *multipartUpload {
+func (u *uploader) load() {
+	records := make(map[string][]byte)
+	err := cache.ListUploads(func(id string, data []byte) {
+		records[id] = append([]byte{}, data...)
+	})
+	if err != nil {
+		logrus.Errorf("[MultipartUpload]Load uploads ERR:%s\n", err)
+		return
+	}
+	var recs []*uploadRecord
+	for id, data := range records {
+		rec := &uploadRecord{}
+		if err := json.Unmarshal(data, rec); err != nil {
+			logrus.Errorf("[MultipartUpload]Load upload %s ERR:%s\n", id, err)
+			cache.DeleteUpload(id)
+			continue
+		}
+		recs = append(recs, rec)
+	}
+	sort.Slice(recs, func(i, j int) bool { return recs[i].Initiated.Before(recs[j].Initiated) })
+	for _, rec := range recs {
+		id := rec.ID
+		mpu := rec.toUpload()
+		if mpu.rootpath != "" {
+			if _, err := os.Stat(mpu.rootpath); err != nil {
+				logrus.Warnf("[MultipartUpload]Upload %s lost part directory %s\n", id, mpu.rootpath)
+				mpu.rootpath = ""
+				mpu.parts = nil
+				mpu.save()
+			}
+		}
+		u.addUnlocked(mpu)
+	}
+	if len(recs) > 0 {
+		logrus.Infof("[MultipartUpload]Loaded %d incomplete uploads\n", len(recs))
+	}
+}
+
+func (u *uploader) addUnlocked(mpu *multipartUpload) {
+	bucketUploads := u.buckets[mpu.Bucket]
+	if bucketUploads == nil {
+		u.buckets[mpu.Bucket] = newBucketUploads()
+		bucketUploads = u.buckets[mpu.Bucket]
+	}
+	bucketUploads.add(mpu)
+}
+
+func (u *uploader) Begin(accesskey, bucket, object string, meta map[string]string, initiated time.Time) *multipartUpload {
 	u.mu.Lock()
 	defer u.mu.Unlock()
-	u.uploadID.Add(u.uploadID, add1)
 	mpu := &multipartUpload{
-		ID:        UploadID(u.uploadID.String()),
+		ID:        UploadID(primitive.NewObjectID().Hex()),
+		AccessKey: accesskey,
 		Bucket:    bucket,
 		Object:    object,
 		Meta:      meta,
 		Initiated: initiated,
 	}
-	bucketUploads := u.buckets[bucket]
-	if bucketUploads == nil {
-		u.buckets[bucket] = newBucketUploads()
-		bucketUploads = u.buckets[bucket]
-	}
-	bucketUploads.add(mpu)
+	u.addUnlocked(mpu)
+	mpu.save()
 	return mpu
 }
 
-func (u *uploader) ListParts(bucket, object string, uploadID UploadID, marker int, limit int64) (*ListMultipartUploadPartsResult, error) {
+func (u *uploader) ListParts(owner, bucket, object string, uploadID UploadID, marker int, limit int64) (*ListMultipartUploadPartsResult, error) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
-	mpu, err := u.getUnlocked(bucket, object, uploadID)
+	mpu, err := u.getUnlocked(owner, bucket, object, uploadID)
 	if err != nil {
 		return nil, err
 	}
@@ -146,7 +190,7 @@ func (u *uploader) ListParts(bucket, object string, uploadID UploadID, marker in
 	return &result, nil
 }
 
-func (u *uploader) List(bucket string, marker *UploadListMarker, prefix Prefix, limit int64) (*ListMultipartUploadsResult, error) {
+func (u *uploader) List(owner, bucket string, marker *UploadListMarker, prefix Prefix, limit int64) (*ListMultipartUploadsResult, error) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
 
@@ -175,7 +219,10 @@ func (u *uploader) List(bucket string, marker *UploadListMarker, prefix Prefix,
 
 	for iter.Next() {
 		object := iter.Key().(string)
-		uploads := iter.Value().([]*multipartUpload)
+		uploads := ownedBy(iter.Value().([]*multipartUpload), owner)
+		if len(uploads) == 0 {
+			continue
+		}
 
 	retry:
 		matched := prefix.Match(object, &match)
@@ -226,9 +273,13 @@ done:
 	if !truncated {
 		for iter.Next() {
 			object := iter.Key().(string)
+			uploads := ownedBy(iter.Value().([]*multipartUpload), owner)
+			if len(uploads) == 0 {
+				continue
+			}
 			if matched := prefix.Match(object, &match); matched && !match.CommonPrefix {
 				truncated = true
-				result.NextUploadIDMarker = iter.Value().([]*multipartUpload)[0].ID
+				result.NextUploadIDMarker = uploads[0].ID
 				result.NextKeyMarker = object
 				break
 			}
@@ -240,24 +291,85 @@ done:
 	return &result, nil
 }
 
-func (u *uploader) Complete(bucket, object string, id UploadID) (*multipartUpload, error) {
+func (u *uploader) Complete(owner, bucket, object string, id UploadID) (*multipartUpload, error) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
-	up, err := u.getUnlocked(bucket, object, id)
+	up, err := u.getUnlocked(owner, bucket, object, id)
 	if err != nil {
 		return nil, err
 	}
 	u.buckets[bucket].remove(id)
+	if err := cache.DeleteUpload(string(id)); err != nil {
+		logrus.Errorf("[MultipartUpload]Delete upload %s ERR:%s\n", id, err)
+	}
 	return up, nil
 }
 
-func (u *uploader) Get(bucket, object string, id UploadID) (mu *multipartUpload, err error) {
+func (u *uploader) Expired(now time.Time, expiry func(mpu *multipartUpload) time.Duration) []*multipartUpload {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	var res []*multipartUpload
+	for _, bu := range u.buckets {
+		for id, mpu := range bu.uploads {
+			ttl := expiry(mpu)
+			if ttl <= 0 || now.Sub(mpu.Initiated) < ttl {
+				continue
+			}
+			bu.remove(id)
+			if err := cache.DeleteUpload(string(id)); err != nil {
+				logrus.Errorf("[MultipartUpload]Delete upload %s ERR:%s\n", id, err)
+			}
+			res = append(res, mpu)
+		}
+	}
+	return res
+}
+
+// uploadOwner is a bucket of one user; bucket names are only unique per user.
+type uploadOwner struct {
+	AccessKey string
+	Bucket    string
+}
+
+func (u *uploader) BucketOwners() map[uploadOwner]bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	owners := make(map[uploadOwner]bool)
+	for bucket, bu := range u.buckets {
+		for _, mpu := range bu.uploads {
+			if mpu.AccessKey != "" {
+				owners[uploadOwner{AccessKey: mpu.AccessKey, Bucket: bucket}] = true
+			}
+		}
+	}
+	return owners
+}
+
+func (u *uploader) ActiveDirs() map[string]bool {
 	u.mu.Lock()
 	defer u.mu.Unlock()
-	return u.getUnlocked(bucket, object, id)
+	dirs := make(map[string]bool)
+	for _, bu := range u.buckets {
+		for _, mpu := range bu.uploads {
+			mpu.mu.Lock()
+			if mpu.rootpath != "" {
+				dirs[filepath.ToSlash(filepath.Clean(mpu.rootpath))] = true
+			}
+			mpu.mu.Unlock()
+		}
+	}
+	return dirs
 }
 
-func (u *uploader) getUnlocked(bucket, object string, id UploadID) (mu *multipartUpload, err error) {
+func (u *uploader) Get(owner, bucket, object string, id UploadID) (mu *multipartUpload, err error) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return u.getUnlocked(owner, bucket, object, id)
+}
+
+// getUnlocked only finds uploads begun by owner, so users sharing a bucket
+// name never see each other's uploads.
+func (u *uploader) getUnlocked(owner, bucket, object string, id UploadID) (mu *multipartUpload, err error) {
 	bucketUps, ok := u.buckets[bucket]
 	if !ok {
 		return nil, ErrNoSuchUpload
@@ -266,12 +378,22 @@ func (u *uploader) getUnlocked(bucket, object string, id UploadID) (mu *multipar
 	if !ok {
 		return nil, ErrNoSuchUpload
 	}
-	if mu.Bucket != bucket || mu.Object != object {
+	if mu.Bucket != bucket || mu.Object != object || mu.AccessKey != owner {
 		return nil, ErrNoSuchUpload
 	}
 	return mu, nil
 }
 
+func ownedBy(uploads []*multipartUpload, owner string) []*multipartUpload {
+	var res []*multipartUpload
+	for _, mpu := range uploads {
+		if mpu.AccessKey == owner {
+			res = append(res, mpu)
+		}
+	}
+	return res
+}
+
 type UploadListMarker struct {
 	Object   string
 	UploadID UploadID
@@ -292,16 +414,42 @@ type multipartUploadPart struct {
 	LastModified ContentTime
 }
 
+type uploadRecord struct {
+	ID        UploadID
+	AccessKey string
+	Bucket    string
+	Object    string
+	Meta      map[string]string
+	Initiated time.Time
+	Parts     []*multipartUploadPart
+	RootPath  string
+}
+
+func (rec *uploadRecord) toUpload() *multipartUpload {
+	return &multipartUpload{
+		ID:        rec.ID,
+		AccessKey: rec.AccessKey,
+		Bucket:    rec.Bucket,
+		Object:    rec.Object,
+		Meta:      rec.Meta,
+		Initiated: rec.Initiated,
+		parts:     rec.Parts,
+		rootpath:  rec.RootPath,
+	}
+}
+
 type multipartUpload struct {
 	ID        UploadID
+	AccessKey string
 	Bucket    string
 	Object    string
 	Meta      map[string]string
 	Initiated time.Time
 
-	parts    []*multipartUploadPart
-	rootpath string
-	mu       sync.Mutex
+	parts       []*multipartUploadPart
+	rootpath    string
+	customerKey *CustomerKey
+	mu          sync.Mutex
 }
 
 func (mpu *multipartUpload) AddPart(partNumber int, at time.Time, rdr io.Reader, contentLength int64) (etag string, err error) {
@@ -344,9 +492,47 @@ func (mpu *multipartUpload) AddPart(partNumber int, at time.Time, rdr io.Reader,
 		mpu.parts = append(mpu.parts, make([]*multipartUploadPart, partNumber-len(mpu.parts)+1)...)
 	}
 	mpu.parts[partNumber] = &part
+	mpu.save()
 	return etag, nil
 }
 
+func (mpu *multipartUpload) RemoveFiles() int64 {
+	mpu.mu.Lock()
+	defer mpu.mu.Unlock()
+	if mpu.rootpath == "" {
+		return 0
+	}
+	size := cache.DirSize(mpu.rootpath)
+	if err := os.RemoveAll(mpu.rootpath); err != nil {
+		logrus.Errorf("[MultipartUpload]Delete %s ERR:%s\n", mpu.rootpath, err)
+		return 0
+	}
+	mpu.rootpath = ""
+	mpu.parts = nil
+	return size
+}
+
+func (mpu *multipartUpload) save() {
+	rec := &uploadRecord{
+		ID:        mpu.ID,
+		AccessKey: mpu.AccessKey,
+		Bucket:    mpu.Bucket,
+		Object:    mpu.Object,
+		Meta:      mpu.Meta,
+		Initiated: mpu.Initiated,
+		Parts:     mpu.parts,
+		RootPath:  mpu.rootpath,
+	}
+	data, err := json.Marshal(rec)
+	if err != nil {
+		logrus.Errorf("[MultipartUpload]Marshal upload %s ERR:%s\n", mpu.ID, err)
+		return
+	}
+	if err := cache.PutUpload(string(mpu.ID), data); err != nil {
+		logrus.Errorf("[MultipartUpload]Save upload %s ERR:%s\n", mpu.ID, err)
+	}
+}
+
 func (mpu *multipartUpload) Reassemble(input *CompleteMultipartUploadRequest) ([]string, error) {
 	mpu.mu.Lock()
 	defer mpu.mu.Unlock()
@@ -374,7 +560,7 @@ func (mpu *multipartUpload) Reassemble(input *CompleteMultipartUploadRequest) ([
 func writeCacheFilePart(path string, input io.Reader) (string, int64, error) {
 	f, err := os.OpenFile(path, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0644)
 	if err != nil {
-		logrus.Errorf("[MultipartUpload]write cache err:%s,open files:%d\n", err)
+		logrus.Errorf("[MultipartUpload]write cache err:%s\n", err)
 		return "", 0, err
 	}
 	defer f.Close()
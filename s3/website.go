@@ -0,0 +1,445 @@
+package s3
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/api/cache"
+	"github.com/yottachain/YTCoreService/env"
+)
+
+type WebsiteConfiguration struct {
+	XMLName               xml.Name               `xml:"WebsiteConfiguration"`
+	Xmlns                 string                 `xml:"xmlns,attr,omitempty"`
+	RedirectAllRequestsTo *RedirectAllRequestsTo `xml:"RedirectAllRequestsTo,omitempty"`
+	IndexDocument         *IndexDocument         `xml:"IndexDocument,omitempty"`
+	ErrorDocument         *ErrorDocument         `xml:"ErrorDocument,omitempty"`
+	RoutingRules          []RoutingRule          `xml:"RoutingRules>RoutingRule,omitempty"`
+}
+
+type IndexDocument struct {
+	Suffix string `xml:"Suffix"`
+}
+
+type ErrorDocument struct {
+	Key string `xml:"Key"`
+}
+
+type RedirectAllRequestsTo struct {
+	HostName string `xml:"HostName"`
+	Protocol string `xml:"Protocol,omitempty"`
+}
+
+type RoutingRule struct {
+	Condition *RoutingCondition `xml:"Condition,omitempty"`
+	Redirect  RoutingRedirect   `xml:"Redirect"`
+}
+
+type RoutingCondition struct {
+	KeyPrefixEquals             string `xml:"KeyPrefixEquals,omitempty"`
+	HttpErrorCodeReturnedEquals string `xml:"HttpErrorCodeReturnedEquals,omitempty"`
+}
+
+type RoutingRedirect struct {
+	HostName             string `xml:"HostName,omitempty"`
+	HttpRedirectCode     string `xml:"HttpRedirectCode,omitempty"`
+	Protocol             string `xml:"Protocol,omitempty"`
+	ReplaceKeyPrefixWith string `xml:"ReplaceKeyPrefixWith,omitempty"`
+	ReplaceKeyWith       string `xml:"ReplaceKeyWith,omitempty"`
+}
+
+type WebsiteBackend interface {
+	WebsiteConfiguration(accesskey, bucket string) (*WebsiteConfiguration, error)
+
+	SetWebsiteConfiguration(accesskey, bucket string, config *WebsiteConfiguration) error
+
+	DeleteWebsiteConfiguration(accesskey, bucket string) error
+}
+
+func validProtocol(p string) bool {
+	return p == "" || p == "http" || p == "https"
+}
+
+func (c *WebsiteConfiguration) Validate() error {
+	if c.RedirectAllRequestsTo != nil {
+		if c.IndexDocument != nil || c.ErrorDocument != nil || len(c.RoutingRules) > 0 {
+			return errors.New("RedirectAllRequestsTo cannot be combined with other website settings")
+		}
+		if c.RedirectAllRequestsTo.HostName == "" || !validProtocol(c.RedirectAllRequestsTo.Protocol) {
+			return errors.New("invalid RedirectAllRequestsTo")
+		}
+		return nil
+	}
+	if c.IndexDocument == nil || c.IndexDocument.Suffix == "" || strings.Contains(c.IndexDocument.Suffix, "/") {
+		return errors.New("IndexDocument suffix must be a non-empty name without slashes")
+	}
+	if c.ErrorDocument != nil && c.ErrorDocument.Key == "" {
+		return errors.New("ErrorDocument key is empty")
+	}
+	for _, rule := range c.RoutingRules {
+		rd := rule.Redirect
+		if rd.ReplaceKeyPrefixWith != "" && rd.ReplaceKeyWith != "" {
+			return errors.New("ReplaceKeyPrefixWith and ReplaceKeyWith are exclusive")
+		}
+		if !validProtocol(rd.Protocol) {
+			return fmt.Errorf("invalid redirect protocol %s", rd.Protocol)
+		}
+		if rd.HttpRedirectCode != "" {
+			if code, err := strconv.Atoi(rd.HttpRedirectCode); err != nil || code < 300 || code > 399 {
+				return fmt.Errorf("invalid HttpRedirectCode %s", rd.HttpRedirectCode)
+			}
+		}
+		if rule.Condition != nil && rule.Condition.HttpErrorCodeReturnedEquals != "" {
+			if code, err := strconv.Atoi(rule.Condition.HttpErrorCodeReturnedEquals); err != nil || code < 400 || code > 599 {
+				return fmt.Errorf("invalid HttpErrorCodeReturnedEquals %s", rule.Condition.HttpErrorCodeReturnedEquals)
+			}
+		}
+	}
+	return nil
+}
+
+// matchRule returns the first routing rule for key; status is zero before
+// the object has been looked up.
+func (c *WebsiteConfiguration) matchRule(key string, status int) *RoutingRule {
+	for i, rule := range c.RoutingRules {
+		cond := rule.Condition
+		if cond == nil {
+			if status == 0 {
+				return &c.RoutingRules[i]
+			}
+			continue
+		}
+		if !strings.HasPrefix(key, cond.KeyPrefixEquals) {
+			continue
+		}
+		if cond.HttpErrorCodeReturnedEquals == "" && status == 0 {
+			return &c.RoutingRules[i]
+		}
+		if cond.HttpErrorCodeReturnedEquals != "" && cond.HttpErrorCodeReturnedEquals == strconv.Itoa(status) {
+			return &c.RoutingRules[i]
+		}
+	}
+	return nil
+}
+
+func (c *WebsiteConfiguration) indexKey(key string) string {
+	if key == "" || strings.HasSuffix(key, "/") {
+		return key + c.IndexDocument.Suffix
+	}
+	return key
+}
+
+func (g *Server) routeWebsiteConfig(bucket string, w http.ResponseWriter, r *http.Request) error {
+	switch r.Method {
+	case "GET":
+		return g.getBucketWebsite(bucket, w, r)
+	case "PUT":
+		return g.putBucketWebsite(bucket, w, r)
+	case "DELETE":
+		return g.deleteBucketWebsite(bucket, w, r)
+	default:
+		return ErrMethodNotAllowed
+	}
+}
+
+func (g *Server) getBucketWebsite(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.website == nil {
+		return ResourceError(ErrNoSuchWebsiteConfiguration, bucket)
+	}
+	config, err := g.website.WebsiteConfiguration(accesskey, bucket)
+	if err != nil {
+		return err
+	}
+	return g.xmlEncoder(w).Encode(config)
+}
+
+func (g *Server) putBucketWebsite(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.website == nil {
+		return ErrNotImplemented
+	}
+	var in WebsiteConfiguration
+	if err := g.xmlDecodeBody(r.Body, &in); err != nil {
+		return err
+	}
+	if err := in.Validate(); err != nil {
+		return ErrorMessage(ErrMalformedXML, err.Error())
+	}
+	logrus.Infof("[S3]PUT WEBSITE:%s\n", bucket)
+	if err := cache.PutWebsiteOwner(bucket, accesskey); err == cache.ErrBucketOwned {
+		return ResourceError(ErrBucketAlreadyExists, bucket)
+	} else if err != nil {
+		return err
+	}
+	if err := g.website.SetWebsiteConfiguration(accesskey, bucket, &in); err != nil {
+		return err
+	}
+	g.accessChanged(accesskey, bucket)
+	return nil
+}
+
+func (g *Server) deleteBucketWebsite(bucket string, w http.ResponseWriter, r *http.Request) error {
+	accesskey, autherr := g.authorize(r, bucket, "", actionOwner)
+	if autherr != nil {
+		return autherr
+	}
+	if err := g.ensureBucketExists(accesskey, bucket); err != nil {
+		return err
+	}
+	if g.website != nil {
+		if err := g.website.DeleteWebsiteConfiguration(accesskey, bucket); err != nil {
+			return err
+		}
+		cache.DeleteWebsiteOwner(bucket, accesskey)
+		g.accessChanged(accesskey, bucket)
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// WebsiteServer serves buckets with a website configuration anonymously,
+// only objects made public through a public-read ACL or a policy Allow.
+// The bucket is taken from the Host header when it is a subdomain of
+// env.S3WebsiteDomain or a bucket name itself, otherwise from the path.
+func (g *Server) WebsiteServer() http.Handler {
+	return http.HandlerFunc(g.routeWebsite)
+}
+
+func (g *Server) routeWebsite(w http.ResponseWriter, r *http.Request) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			env.TraceError("RouteWebsite")
+			g.websiteError(w, r, errors.New("service ERR"))
+		}
+	}()
+	w.Header().Set("x-amz-request-id", fmt.Sprintf("%016X", g.nextRequestID()))
+	w.Header().Set("Server", "AmazonS3")
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		g.websiteError(w, r, ErrMethodNotAllowed)
+		return
+	}
+	bucket, key, base := g.websiteTarget(r)
+	if bucket == "" {
+		g.websiteError(w, r, ResourceError(ErrNoSuchBucket, bucket))
+		return
+	}
+	if err := g.serveWebsite(bucket, key, base, w, r); err != nil {
+		g.websiteError(w, r, err)
+	}
+}
+
+func (g *Server) websiteTarget(r *http.Request) (bucket, key, base string) {
+	host := strings.ToLower(r.Host)
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/")
+	if env.S3WebsiteDomain != "" && strings.HasSuffix(host, "."+env.S3WebsiteDomain) {
+		return strings.TrimSuffix(host, "."+env.S3WebsiteDomain), path, ""
+	}
+	if host != "" && g.websiteOwner(host) != "" {
+		return host, path, ""
+	}
+	parts := strings.SplitN(path, "/", 2)
+	if len(parts) == 2 {
+		key = parts[1]
+	}
+	return parts[0], key, "/" + parts[0]
+}
+
+// websiteOwner returns the user who configured the website of bucket. Sites
+// set up before website owners were recorded are claimed for the user the
+// bucket was shared by, if that user has a website configuration.
+func (g *Server) websiteOwner(bucket string) string {
+	if owner := cache.GetWebsiteOwner(bucket); owner != "" {
+		return owner
+	}
+	owner := cache.GetBucketOwner(bucket)
+	if owner == "" || g.website == nil {
+		return ""
+	}
+	if _, err := g.websiteConfig(owner, bucket); err != nil {
+		return ""
+	}
+	if cache.PutWebsiteOwner(bucket, owner) != nil {
+		return ""
+	}
+	return owner
+}
+
+// publicRead reports whether anonymous users may read key, through a
+// public-read ACL or an Allow in the bucket policy.
+func (g *Server) publicRead(owner, bucket, key string) bool {
+	return g.allowed("", owner, bucket, key, "", ActionGetObject)
+}
+
+func (g *Server) websiteConfig(owner, bucket string) (*WebsiteConfiguration, error) {
+	ck := "web/" + owner + "/" + bucket
+	if v, ok := accessCache.Get(ck); ok {
+		return v.(*WebsiteConfiguration), nil
+	}
+	config, err := g.website.WebsiteConfiguration(owner, bucket)
+	if err != nil {
+		return nil, err
+	}
+	accessCache.SetDefault(ck, config)
+	return config, nil
+}
+
+func (g *Server) serveWebsite(bucket, key, base string, w http.ResponseWriter, r *http.Request) error {
+	owner := g.websiteOwner(bucket)
+	if owner == "" || g.website == nil {
+		return ResourceError(ErrNoSuchBucket, bucket)
+	}
+	config, err := g.websiteConfig(owner, bucket)
+	if err != nil {
+		return err
+	}
+	if to := config.RedirectAllRequestsTo; to != nil {
+		protocol := to.Protocol
+		if protocol == "" {
+			protocol = "http"
+		}
+		http.Redirect(w, r, protocol+"://"+to.HostName+"/"+key, http.StatusMovedPermanently)
+		return nil
+	}
+	if rule := config.matchRule(key, 0); rule != nil {
+		return g.websiteRedirect(rule, key, base, w, r)
+	}
+	err = g.writeWebsiteObject(owner, bucket, config.indexKey(key), http.StatusOK, w, r)
+	if err == nil {
+		return nil
+	}
+	if HasErrorCode(err, ErrNoSuchKey) && key != "" && !strings.HasSuffix(key, "/") && g.publicRead(owner, bucket, key+"/"+config.IndexDocument.Suffix) {
+		if obj, herr := g.storage.HeadObject(owner, bucket, key+"/"+config.IndexDocument.Suffix); herr == nil {
+			obj.Contents.Close()
+			http.Redirect(w, r, base+"/"+key+"/", http.StatusFound)
+			return nil
+		}
+	}
+	status := EnsureErrorResponse(err, "").ErrorCode().Status()
+	if rule := config.matchRule(key, status); rule != nil {
+		return g.websiteRedirect(rule, key, base, w, r)
+	}
+	if config.ErrorDocument != nil && status >= 400 && status < 500 {
+		if derr := g.writeWebsiteObject(owner, bucket, config.ErrorDocument.Key, status, w, r); derr == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func (g *Server) websiteRedirect(rule *RoutingRule, key, base string, w http.ResponseWriter, r *http.Request) error {
+	rd := rule.Redirect
+	target := key
+	if rd.ReplaceKeyWith != "" {
+		target = rd.ReplaceKeyWith
+	} else if rd.ReplaceKeyPrefixWith != "" || (rule.Condition != nil && rule.Condition.KeyPrefixEquals != "") {
+		prefix := ""
+		if rule.Condition != nil {
+			prefix = rule.Condition.KeyPrefixEquals
+		}
+		target = rd.ReplaceKeyPrefixWith + strings.TrimPrefix(key, prefix)
+	}
+	location := base + "/" + target
+	if rd.HostName != "" || rd.Protocol != "" {
+		protocol, host := rd.Protocol, rd.HostName
+		if protocol == "" {
+			protocol = "http"
+		}
+		if host == "" {
+			host = r.Host
+		}
+		location = protocol + "://" + host + "/" + target
+	}
+	code := http.StatusMovedPermanently
+	if rd.HttpRedirectCode != "" {
+		code, _ = strconv.Atoi(rd.HttpRedirectCode)
+	}
+	http.Redirect(w, r, location, code)
+	return nil
+}
+
+func (g *Server) writeWebsiteObject(owner, bucket, key string, status int, w http.ResponseWriter, r *http.Request) error {
+	if !g.publicRead(owner, bucket, key) {
+		return ErrAccessDenied
+	}
+	var rnge *ObjectRangeRequest
+	if status == http.StatusOK {
+		var err error
+		if rnge, err = parseRangeHeader(r.Header.Get("Range")); err != nil {
+			return err
+		}
+	}
+	obj, err := g.storage.GetObject(owner, bucket, key, rnge)
+	if err != nil {
+		return err
+	}
+	if obj == nil {
+		return ErrInternal
+	}
+	defer obj.Contents.Close()
+	if err := CheckCustomerKey(obj.Metadata, nil); err != nil {
+		return err
+	}
+	if err := g.writeGetOrHeadObjectResponse(obj, w, r); err != nil {
+		return err
+	}
+	if status == http.StatusOK {
+		obj.Range.writeHeader(obj.Size, w)
+	} else {
+		w.Header().Set("Content-Length", strconv.FormatInt(obj.Size, 10))
+		w.WriteHeader(status)
+	}
+	if r.Method == http.MethodHead {
+		return nil
+	}
+	_, err = io.Copy(w, obj.Contents)
+	return err
+}
+
+func (g *Server) websiteError(w http.ResponseWriter, r *http.Request, err error) {
+	resp := EnsureErrorResponse(err, "")
+	code := resp.ErrorCode()
+	if code == ErrNotModified {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if code == ErrInternal {
+		logrus.Errorf("[S3]Website ERR:%s\n", err)
+	}
+	status := code.Status()
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if r.Method == http.MethodHead {
+		return
+	}
+	title := fmt.Sprintf("%d %s", status, http.StatusText(status))
+	message := code.Message()
+	if message == "" {
+		message = http.StatusText(status)
+	}
+	fmt.Fprintf(w, "<html>\n<head><title>%s</title></head>\n<body>\n<h1>%s</h1>\n<ul>\n<li>Code: %s</li>\n<li>Message: %s</li>\n<li>RequestId: %s</li>\n</ul>\n</body>\n</html>\n",
+		title, title, html.EscapeString(string(code)), html.EscapeString(message), w.Header().Get("x-amz-request-id"))
+}
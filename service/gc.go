@@ -87,6 +87,12 @@ func listUser(InArrears bool) {
 }
 
 func IterateObjects(user *dao.User, del bool) {
+	locked, err := dao.ListLockedVersions(uint32(user.UserID), time.Now())
+	if err != nil {
+		logrus.Errorf("[GC][%d]List locked versions ERR:%s,skip\n", user.UserID, err)
+		return
+	}
+	retained := 0
 	firstId := primitive.NilObjectID
 	for {
 		vnus, err := dao.ListObjectsForDel(uint32(user.UserID), firstId, 10000, del)
@@ -98,7 +104,10 @@ func IterateObjects(user *dao.User, del bool) {
 			break
 		}
 		for _, vnu := range vnus {
-			if time.Now().Unix()-vnu.Timestamp().Unix() >= 60*60*24 {
+			if locked[vnu] {
+				logrus.Warnf("[GC][%d]Object %s is locked,retained\n", user.UserID, vnu.Hex())
+				retained++
+			} else if time.Now().Unix()-vnu.Timestamp().Unix() >= 60*60*24 {
 				delBlocks(user.UserID, vnu, false, del)
 			}
 			firstId = vnu
@@ -107,4 +116,7 @@ func IterateObjects(user *dao.User, del bool) {
 			break
 		}
 	}
+	if retained > 0 {
+		logrus.Infof("[GC][%d]%d locked objects retained\n", user.UserID, retained)
+	}
 }
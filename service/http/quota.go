@@ -0,0 +1,85 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/dao"
+	"github.com/yottachain/YTCoreService/pkt"
+)
+
+// BucketQuotaHandle shows the quota of a bucket and, on POST, sets it:
+// /bucketquota?username=&bucket=&maxSizeBytes=&maxObjects=
+// Zero or missing limits are unlimited. Quotas are only changed here, so
+// the API is refused unless HttpRemoteIp restricts who may call it.
+func BucketQuotaHandle(w http.ResponseWriter, req *http.Request) {
+	b := checkRoutine()
+	defer atomic.AddInt32(RoutineConter, -1)
+	if !b {
+		WriteErr(w, "HTTP_ROUTINE:Too many routines")
+		return
+	}
+	if len(ip_list) == 0 || !checkIp(req.RemoteAddr) {
+		WriteErr(w, fmt.Sprintf("Invalid IP:%s", req.RemoteAddr))
+		return
+	}
+	queryForm, err := url.ParseQuery(req.URL.RawQuery)
+	if err != nil {
+		WriteErr(w, "Bad request:"+err.Error())
+		return
+	}
+	username := queryForm.Get("username")
+	bucket := queryForm.Get("bucket")
+	if username == "" || bucket == "" {
+		WriteErr(w, "Paramter 'username' or 'bucket' is NULL")
+		return
+	}
+	user := dao.GetUserByUsername(username)
+	if user == nil {
+		WriteErr(w, "Invalid username:"+username)
+		return
+	}
+	bmeta, err := dao.GetBucketByName(bucket, user.UserID)
+	if err != nil || bmeta == nil {
+		WriteErr(w, "Invalid bucket:"+bucket)
+		return
+	}
+	if !checkPostMethod(req) {
+		quota := pkt.BucketQuotaFromMeta(bmeta.Meta)
+		if quota == nil {
+			quota = &pkt.BucketQuota{}
+		}
+		WriteJson(w, fmt.Sprintf(`{"maxSizeBytes":%d,"maxObjects":%d}`, quota.MaxSize, quota.MaxObjects))
+		return
+	}
+	quota := &pkt.BucketQuota{}
+	if s := queryForm.Get("maxSizeBytes"); s != "" {
+		if quota.MaxSize, err = strconv.ParseInt(s, 10, 64); err != nil {
+			WriteErr(w, "Bad request:maxSizeBytes")
+			return
+		}
+	}
+	if s := queryForm.Get("maxObjects"); s != "" {
+		if quota.MaxObjects, err = strconv.ParseInt(s, 10, 64); err != nil {
+			WriteErr(w, "Bad request:maxObjects")
+			return
+		}
+	}
+	if err = quota.Validate(); err != nil {
+		WriteErr(w, "Bad request:"+err.Error())
+		return
+	}
+	err = dao.ModifyBucketMeta(bmeta, func(meta []byte) ([]byte, error) {
+		return pkt.SetBucketQuota(meta, quota)
+	})
+	if err != nil {
+		WriteErr(w, "BucketQuotaHandle err:"+err.Error())
+		return
+	}
+	logrus.Infof("[Http]Set bucket quota:%s/%s,size:%d,objects:%d\n", username, bucket, quota.MaxSize, quota.MaxObjects)
+	WriteText(w, "OK")
+}
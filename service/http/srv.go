@@ -49,6 +49,7 @@ func StartHttp(port int) error {
 
 	http.HandleFunc("/statistics", StatisticsHandle)
 	http.HandleFunc("/relationship", RelationshipHandle)
+	http.HandleFunc("/bucketquota", BucketQuotaHandle)
 	http.HandleFunc("/newnodeid", NewnodeidHandle)
 	http.HandleFunc("/preregnode", PreregnodeHandle)
 	http.HandleFunc("/changeminerpool", ChangeminerpoolHandle)
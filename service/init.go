@@ -12,12 +12,14 @@ import (
 
 func StartService() {
 	go initLog()
+	startNotify()
 	if env.SUM_SERVICE {
 		go startIterateShards()
 		go startDoCacheFee()
 		go startDoCycleFee()
 		go startDoDelete()
 		go startGC()
+		go startLifecycle()
 		go startRelationshipSum()
 	}
 }
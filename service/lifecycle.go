@@ -0,0 +1,199 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/dao"
+	"github.com/yottachain/YTCoreService/env"
+	"github.com/yottachain/YTCoreService/handle"
+	"github.com/yottachain/YTCoreService/pkt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const LIFECYCLE_LIST_LIMIT = 100
+
+func startLifecycle() {
+	for {
+		time.Sleep(time.Duration(20 * time.Minute))
+		iterateLifecycle()
+		time.Sleep(time.Duration(env.LifecycleInterval) * time.Hour)
+	}
+}
+
+func iterateLifecycle() {
+	defer env.TracePanic("[Lifecycle]")
+	var lastId int32 = 0
+	logrus.Infof("[Lifecycle]Start iterate user...\n")
+	for {
+		us, err := dao.ListUsers(lastId, 100, bson.M{"_id": 1, "username": 1})
+		if err != nil {
+			time.Sleep(time.Duration(30) * time.Second)
+			continue
+		}
+		if len(us) == 0 {
+			break
+		}
+		for _, user := range us {
+			lastId = user.UserID
+			bs, err := dao.ListBucketMeta(user.UserID)
+			if err != nil {
+				logrus.Errorf("[Lifecycle][%s][%d]List bucket ERR:%s\n", user.Username, user.UserID, err)
+				continue
+			}
+			for _, bmeta := range bs {
+				conf := bucketLifecycle(bmeta)
+				if conf != nil {
+					applyLifecycle(user, bmeta, conf)
+				}
+			}
+		}
+	}
+	logrus.Infof("[Lifecycle]Iterate user OK!\n")
+}
+
+func bucketLifecycle(bmeta *dao.BucketMeta) *pkt.LifecycleConfiguration {
+	if len(bmeta.Meta) == 0 {
+		return nil
+	}
+	m, err := pkt.UnmarshalMap(bmeta.Meta)
+	if err != nil {
+		return nil
+	}
+	s, ok := m[pkt.BucketMetaLifecycle]
+	if !ok || s == "" {
+		return nil
+	}
+	conf, err := pkt.ParseLifecycle([]byte(s))
+	if err != nil {
+		logrus.Errorf("[Lifecycle][%d]Bucket %s,invalid configuration:%s\n", bmeta.UserId, bmeta.BucketName, err)
+		return nil
+	}
+	return conf
+}
+
+func applyLifecycle(user *dao.User, bmeta *dao.BucketMeta, conf *pkt.LifecycleConfiguration) {
+	var rules []*pkt.LifecycleRule
+	for _, rule := range conf.Rules {
+		if rule.Enabled() && (rule.Expiration != nil || rule.NoncurrentVersionExpiration != nil) {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return
+	}
+	prefix := rules[0].KeyPrefix()
+	for _, rule := range rules[1:] {
+		prefix = commonPrefix(prefix, rule.KeyPrefix())
+	}
+	uid := bmeta.UserId
+	var count int
+	lastName := ""
+	lastId := primitive.NilObjectID
+	for {
+		files, err := dao.ListFileMeta(uint32(uid), bmeta.BucketId, prefix, lastName, primitive.NilObjectID, LIFECYCLE_LIST_LIMIT, true)
+		if err != nil {
+			logrus.Errorf("[Lifecycle][%d]Bucket %s,list ERR:%s\n", uid, bmeta.BucketName, err)
+			return
+		}
+		progress := false
+		for _, fmeta := range files {
+			if fmeta.FileId == lastId {
+				continue
+			}
+			progress = true
+			n, removed := expireFile(user, bmeta, fmeta, rules)
+			count = count + n
+			if !removed {
+				lastName = fmeta.FileName
+				lastId = fmeta.FileId
+			}
+		}
+		if !progress {
+			break
+		}
+	}
+	if count > 0 {
+		logrus.Infof("[Lifecycle][%d]Bucket %s,deleted %d object versions\n", uid, bmeta.BucketName, count)
+	}
+}
+
+func expireFile(user *dao.User, bmeta *dao.BucketMeta, fmeta *dao.FileMetaWithVersion, rules []*pkt.LifecycleRule) (int, bool) {
+	uid, bid := bmeta.UserId, bmeta.BucketId
+	size := len(fmeta.Version)
+	if size == 0 {
+		return 0, false
+	}
+	now := time.Now()
+	current := fmeta.Version[size-1]
+	created := current.VersionId.Timestamp()
+	if current.CurrentSince > created.Unix() {
+		created = time.Unix(current.CurrentSince, 0)
+	}
+	// Versioned buckets only lose the current version, the older ones are
+	// left to NoncurrentVersionExpiration. The SN keeps no delete markers, so
+	// the previous version becomes current and ages from then on.
+	versioned := pkt.BucketVersioning(bmeta.Meta) != ""
+	for _, rule := range rules {
+		if !rule.Matches(fmeta.FileName) || !rule.Expired(created, now) {
+			continue
+		}
+		if versioned && current.CurrentSince != 0 && rule.Expiration.Date != "" {
+			continue
+		}
+		verid := primitive.NilObjectID
+		if versioned {
+			verid = current.VersionId
+		}
+		metaWVer, err := handle.DeleteFile(uid, bid, fmeta.FileName, verid, false)
+		if err != nil {
+			if err == dao.ErrObjectLocked {
+				logrus.Infof("[Lifecycle][%d]%s is locked,not expired\n", uid, fmeta.FileName)
+			}
+			return 0, false
+		}
+		if metaWVer != nil {
+			for _, ver := range metaWVer.Version {
+				handle.NotifyObjectRemoved(user, bmeta, fmeta.FileName, ver.VersionId)
+			}
+		}
+		logrus.Debugf("[Lifecycle][%d]Expired %s,rule:%s\n", uid, fmeta.FileName, rule.ID)
+		if !versioned || size == 1 {
+			return size, true
+		}
+		prev := &dao.FileMeta{UserId: uid, BucketId: bid, FileName: fmeta.FileName, VersionId: fmeta.Version[size-2].VersionId}
+		prev.SetCurrentSince(now)
+		return 1, false
+	}
+	count := 0
+	for ii := size - 2; ii >= 0; ii-- {
+		newer := size - 2 - ii
+		since := fmeta.Version[ii+1].VersionId.Timestamp()
+		for _, rule := range rules {
+			if rule.Matches(fmeta.FileName) && rule.NoncurrentExpired(newer, since, now) {
+				_, err := handle.DeleteFile(uid, bid, fmeta.FileName, fmeta.Version[ii].VersionId, false)
+				if err == nil {
+					count++
+					handle.NotifyObjectRemoved(user, bmeta, fmeta.FileName, fmeta.Version[ii].VersionId)
+				} else if err == dao.ErrObjectLocked {
+					logrus.Infof("[Lifecycle][%d]%s version %s is locked,not expired\n", uid, fmeta.FileName, fmeta.Version[ii].VersionId.Hex())
+				}
+				break
+			}
+		}
+	}
+	return count, false
+}
+
+func commonPrefix(a, b string) string {
+	if strings.HasPrefix(b, a) {
+		return a
+	}
+	n := 0
+	for n < len(a) && n < len(b) && a[n] == b[n] {
+		n++
+	}
+	return a[:n]
+}
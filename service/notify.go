@@ -0,0 +1,149 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/yottachain/YTCoreService/dao"
+	"github.com/yottachain/YTCoreService/env"
+)
+
+const (
+	NOTIFY_LEASE        = time.Minute
+	NOTIFY_MIN_INTERVAL = 10 * time.Second
+	NOTIFY_MAX_INTERVAL = time.Hour
+)
+
+// Webhooks are owner supplied URLs, so deliveries only go to public
+// addresses, checked after resolution, and redirects are not followed.
+// Hosts listed in notifyAllowHost may also be on internal networks.
+var notifyClient = &http.Client{
+	Timeout: 15 * time.Second,
+	Transport: &http.Transport{
+		DialContext:         dialWebhook,
+		TLSHandshakeTimeout: 10 * time.Second,
+		MaxIdleConnsPerHost: 2,
+	},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+var notifyAllowHosts = make(map[string]bool)
+
+var (
+	webhookDialer  = &net.Dialer{Timeout: 10 * time.Second, Control: checkWebhookAddr}
+	internalDialer = &net.Dialer{Timeout: 10 * time.Second}
+)
+
+var reservedNets []*net.IPNet
+
+func init() {
+	for _, s := range []string{"0.0.0.0/8", "100.64.0.0/10", "192.0.0.0/24", "198.18.0.0/15", "240.0.0.0/4", "64:ff9b::/96"} {
+		_, n, _ := net.ParseCIDR(s)
+		reservedNets = append(reservedNets, n)
+	}
+}
+
+func dialWebhook(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	if notifyAllowHosts[strings.ToLower(host)] {
+		return internalDialer.DialContext(ctx, network, addr)
+	}
+	return webhookDialer.DialContext(ctx, network, addr)
+}
+
+func checkWebhookAddr(network, address string, c syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !publicIP(ip) {
+		return fmt.Errorf("webhook address %s is not public", host)
+	}
+	return nil
+}
+
+func publicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+		return false
+	}
+	for _, n := range reservedNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func startNotify() {
+	for _, host := range strings.Split(env.NotifyAllowHost, ";") {
+		if host = strings.TrimSpace(host); host != "" {
+			notifyAllowHosts[strings.ToLower(host)] = true
+		}
+	}
+	for ii := 0; ii < env.NotifyRoutine; ii++ {
+		go deliverEvents()
+	}
+}
+
+// deliverEvents posts outbox events to their webhooks. Events are deleted
+// once a webhook answers 2xx, failed ones are retried with backoff; since a
+// claimed event reappears after the lease, delivery is at least once.
+func deliverEvents() {
+	for {
+		event := dao.ClaimEventLOG(time.Now(), NOTIFY_LEASE)
+		if event == nil {
+			time.Sleep(5 * time.Second)
+			continue
+		}
+		err := postEvent(event)
+		if err == nil {
+			dao.DeleteEventLOG(event.Id)
+			continue
+		}
+		event.Attempts++
+		if event.Attempts > env.NotifyMaxRetry {
+			logrus.Errorf("[Notify][%d]Deliver to %s ERR:%s,dropped after %d attempts\n", event.UID, event.Url, err, event.Attempts)
+			dao.DeleteEventLOG(event.Id)
+			continue
+		}
+		logrus.Warnf("[Notify][%d]Deliver to %s ERR:%s,retry %d\n", event.UID, event.Url, err, event.Attempts)
+		dao.RetryEventLOG(event.Id, event.Attempts, time.Now().Add(retryInterval(event.Attempts)))
+	}
+}
+
+func retryInterval(attempts int) time.Duration {
+	d := NOTIFY_MIN_INTERVAL
+	for ii := 1; ii < attempts && d < NOTIFY_MAX_INTERVAL; ii++ {
+		d = d * 2
+	}
+	if d > NOTIFY_MAX_INTERVAL {
+		d = NOTIFY_MAX_INTERVAL
+	}
+	return d
+}
+
+func postEvent(event *dao.EventLOG) error {
+	resp, err := notifyClient.Post(event.Url, "application/json", bytes.NewReader(event.Body))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	return nil
+}